@@ -0,0 +1,76 @@
+// Package auth 服务之间共用的认证中间件
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// 运营后台 token 的 aud
+const AdminAudience = "admin"
+
+// ctx 中操作人的 key
+type operatorKey struct{}
+
+// 运营后台的认证：校验运营后台签发的 HS256 token，aud 需要为 admin，sub 作为操作人放入 ctx
+// 未配置密钥时拒绝所有请求
+func Admin(secret string) middleware.Middleware {
+	return verify(secret, AdminAudience, func(ctx context.Context, subject string) context.Context {
+		return context.WithValue(ctx, operatorKey{}, subject)
+	})
+}
+
+// Admin 中间件放入 ctx 的操作人
+func Operator(ctx context.Context) string {
+	operator, _ := ctx.Value(operatorKey{}).(string)
+	return operator
+}
+
+// 校验 HS256 token 的签名、有效期和 aud，sub 不能为空，通过后由 with 将 sub 放入 ctx
+func verify(secret, audience string, with func(ctx context.Context, subject string) context.Context) middleware.Middleware {
+	parse := jwt.Server(func(token *jwtv5.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithSigningMethod(jwtv5.SigningMethodHS256))
+	return func(handler middleware.Handler) middleware.Handler {
+		next := parse(func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("Unauthorized", "claims not found")
+			}
+			claimsMap, ok := claims.(jwtv5.MapClaims)
+			if !ok {
+				return nil, errors.Unauthorized("Unauthorized", "claims invalid")
+			}
+			aud, err := claimsMap.GetAudience()
+			if err != nil || !contains(aud, audience) {
+				return nil, errors.Unauthorized("Unauthorized", "token is not a "+audience+" token")
+			}
+			subject, err := claimsMap.GetSubject()
+			subject = strings.TrimSpace(subject)
+			if err != nil || subject == "" {
+				return nil, errors.Unauthorized("Unauthorized", "subject not found")
+			}
+			return handler(with(ctx, subject), req)
+		})
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if secret == "" {
+				return nil, errors.Unauthorized("Unauthorized", audience+" auth is not configured")
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func contains(list []string, want string) bool {
+	for _, v := range list {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string      { return http.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { http.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { http.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return http.Header(hc).Values(key) }
+
+type testTransport struct {
+	header headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "/test" }
+func (tr *testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func sign(t *testing.T, secret string, claims jwtv5.MapClaims) string {
+	t.Helper()
+	token, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAdmin(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	exp := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name     string
+		secret   string
+		token    string
+		operator string
+	}{
+		{"合法", secret, sign(t, secret, jwtv5.MapClaims{"aud": "admin", "sub": "alice", "exp": exp}), "alice"},
+		{"aud 为数组", secret, sign(t, secret, jwtv5.MapClaims{"aud": []string{"ops", "admin"}, "sub": "bob", "exp": exp}), "bob"},
+		{"司机的 token", secret, sign(t, secret, jwtv5.MapClaims{"aud": "driver", "sub": "alice", "exp": exp}), ""},
+		{"缺少操作人", secret, sign(t, secret, jwtv5.MapClaims{"aud": "admin", "sub": " ", "exp": exp}), ""},
+		{"密钥错误", secret, sign(t, "another secret", jwtv5.MapClaims{"aud": "admin", "sub": "alice", "exp": exp}), ""},
+		{"已过期", secret, sign(t, secret, jwtv5.MapClaims{"aud": "admin", "sub": "alice", "exp": time.Now().Add(-time.Minute).Unix()}), ""},
+		{"没有 token", secret, "", ""},
+		{"未配置密钥", "", sign(t, "", jwtv5.MapClaims{"aud": "admin", "sub": "alice", "exp": exp}), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := headerCarrier{}
+			if tt.token != "" {
+				header.Set("Authorization", "Bearer "+tt.token)
+			}
+			ctx := transport.NewServerContext(context.Background(), &testTransport{header: header})
+			var got string
+			_, err := Admin(tt.secret)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = Operator(ctx)
+				return nil, nil
+			})(ctx, nil)
+			if tt.operator == "" {
+				if err == nil {
+					t.Fatalf("want error, operator %q", got)
+				}
+				return
+			}
+			if err != nil || got != tt.operator {
+				t.Fatalf("operator = %q, %v, want %q", got, err, tt.operator)
+			}
+		})
+	}
+}
+
+func TestService(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	// 客户端中间件签发的 token
+	header := headerCarrier{}
+	ctx := transport.NewClientContext(context.Background(), &testTransport{header: header})
+	if _, err := ServiceClient(secret, "Driver")(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	signed := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	exp := time.Now().Add(time.Minute).Unix()
+	tests := []struct {
+		name   string
+		secret string
+		token  string
+		caller string
+	}{
+		{"客户端签发", secret, signed, "Driver"},
+		{"合法", secret, sign(t, secret, jwtv5.MapClaims{"aud": "service", "sub": "Order", "exp": exp}), "Order"},
+		{"运营后台的 token", secret, sign(t, secret, jwtv5.MapClaims{"aud": "admin", "sub": "alice", "exp": exp}), ""},
+		{"密钥错误", secret, sign(t, "another secret", jwtv5.MapClaims{"aud": "service", "sub": "Order", "exp": exp}), ""},
+		{"没有 token", secret, "", ""},
+		{"未配置密钥", "", signed, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := headerCarrier{}
+			if tt.token != "" {
+				header.Set("Authorization", "Bearer "+tt.token)
+			}
+			ctx := transport.NewServerContext(context.Background(), &testTransport{header: header})
+			var got string
+			_, err := Service(tt.secret)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = Caller(ctx)
+				return nil, nil
+			})(ctx, nil)
+			if tt.caller == "" {
+				if err == nil {
+					t.Fatalf("want error, caller %q", got)
+				}
+				return
+			}
+			if err != nil || got != tt.caller {
+				t.Fatalf("caller = %q, %v, want %q", got, err, tt.caller)
+			}
+		})
+	}
+}
+
+func TestServiceClientWithoutSecret(t *testing.T) {
+	header := headerCarrier{}
+	ctx := transport.NewClientContext(context.Background(), &testTransport{header: header})
+	if _, err := ServiceClient("", "Driver")(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if header.Get("Authorization") != "" {
+		t.Fatal("token sent without a secret")
+	}
+}
@@ -0,0 +1,20 @@
+module common
+
+go 1.21
+
+require (
+	github.com/go-kratos/kratos/v2 v2.7.3
+	github.com/golang-jwt/jwt/v5 v5.2.1
+	google.golang.org/grpc v1.56.3
+)
+
+require (
+	github.com/go-kratos/aegis v0.2.0 // indirect
+	github.com/go-playground/form/v4 v4.2.0 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	github.com/google/uuid v1.3.0 // indirect
+	github.com/gorilla/mux v1.8.1 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230629202037-9506855d4529 // indirect
+	google.golang.org/protobuf v1.31.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
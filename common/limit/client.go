@@ -0,0 +1,109 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// 受信任的反向代理，只有来自这些地址的请求才使用 X-Forwarded-For 和 X-Real-IP
+type TrustedProxies struct {
+	nets []*net.IPNet
+}
+
+// TrustedProxies 构造器，proxies 为 IP 或 CIDR，例如 127.0.0.1、10.0.0.0/8
+func NewTrustedProxies(proxies []string) (*TrustedProxies, error) {
+	tp := &TrustedProxies{}
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			tp.nets = append(tp.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		tp.nets = append(tp.nets, n)
+	}
+	return tp, nil
+}
+
+func (tp *TrustedProxies) trusted(ip net.IP) bool {
+	if tp == nil || ip == nil {
+		return false
+	}
+	for _, n := range tp.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// 客户端的 IP 和设备 ID，设备 ID 来自请求头 X-Device-Id
+// 连接的对端不是受信任的代理时直接使用对端地址，请求头可以被客户端伪造；
+// 是受信任的代理时，从右向左跳过 X-Forwarded-For 中受信任的代理，第一个不受信任的地址为客户端，
+// X-Forwarded-For 中没有时使用代理设置的 X-Real-IP
+func (tp *TrustedProxies) ClientInfo(ctx context.Context) (ip, device string) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	device = strings.TrimSpace(tr.RequestHeader().Get("X-Device-Id"))
+	remote := remoteIP(ctx, tr)
+	if remote == nil {
+		return "", device
+	}
+	if !tp.trusted(remote) {
+		return remote.String(), device
+	}
+	client := remote
+	forwarded := strings.Split(strings.Join(tr.RequestHeader().Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		s := strings.TrimSpace(forwarded[i])
+		if s == "" {
+			continue
+		}
+		hop := net.ParseIP(s)
+		if hop == nil {
+			// 格式错误的地址之前的部分不可信，使用最后一个受信任的代理
+			return client.String(), device
+		}
+		if !tp.trusted(hop) {
+			return hop.String(), device
+		}
+		client = hop
+	}
+	if real := net.ParseIP(strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP"))); real != nil {
+		return real.String(), device
+	}
+	return client.String(), device
+}
+
+// 连接的对端地址
+func remoteIP(ctx context.Context, tr transport.Transporter) net.IP {
+	var addr string
+	if ht, ok := tr.(http.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,41 @@
+// Package limit 验证码发送的限流中间件，号码、IP、设备的计数由验证码服务完成，顾客服务和司机服务共用
+package limit
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 调用验证码服务的 Allow，由各服务使用自己的客户端实现
+type AllowFunc func(ctx context.Context, subject, ip, device string) error
+
+// 号码的格式校验，由各服务提供
+type ValidFunc func(subject string) bool
+
+// 验证码发送的限流中间件，请求需要有 GetTelephone 方法
+// 号码格式错误的请求不计数，交给 handler 返回格式错误，避免无效号码占用冷却和每天的次数
+// 被拒绝时返回验证码服务的错误，并设置 Retry-After 响应头
+func VerifyCode(allow AllowFunc, valid ValidFunc, proxies *TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			r, ok := req.(interface{ GetTelephone() string })
+			if !ok || valid != nil && !valid(r.GetTelephone()) {
+				return handler(ctx, req)
+			}
+			ip, device := proxies.ClientInfo(ctx)
+			if err := allow(ctx, r.GetTelephone(), ip, device); err != nil {
+				e := errors.FromError(err)
+				if retry, ok := e.Metadata["retry_after"]; ok {
+					if tr, ok := transport.FromServerContext(ctx); ok {
+						tr.ReplyHeader().Set("Retry-After", retry)
+					}
+				}
+				return nil, e
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package limit
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/peer"
+)
+
+type headerCarrier nethttp.Header
+
+func (hc headerCarrier) Get(key string) string      { return nethttp.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { nethttp.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { nethttp.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return nethttp.Header(hc).Values(key) }
+
+// gRPC 的 transport，对端地址在 peer 中
+type grpcTransport struct {
+	header headerCarrier
+	reply  headerCarrier
+}
+
+func (tr *grpcTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (tr *grpcTransport) Endpoint() string                { return "" }
+func (tr *grpcTransport) Operation() string               { return "/test" }
+func (tr *grpcTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *grpcTransport) ReplyHeader() transport.Header   { return tr.reply }
+
+// HTTP 的 transport，对端地址为 Request().RemoteAddr
+type httpTransport struct {
+	grpcTransport
+	request *nethttp.Request
+}
+
+func (tr *httpTransport) Kind() transport.Kind      { return transport.KindHTTP }
+func (tr *httpTransport) Request() *nethttp.Request { return tr.request }
+func (tr *httpTransport) PathTemplate() string      { return "" }
+
+func httpContext(remote string, header map[string][]string) context.Context {
+	tr := &httpTransport{request: &nethttp.Request{RemoteAddr: remote}}
+	tr.header, tr.reply = headerCarrier(header), headerCarrier{}
+	return transport.NewServerContext(context.Background(), tr)
+}
+
+func TestClientInfo(t *testing.T) {
+	tp, err := NewTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8", "::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		remote string
+		header map[string][]string
+		ip     string
+	}{
+		{"直连", "203.0.113.7:51234", nil, "203.0.113.7"},
+		{"直连时忽略伪造的请求头", "203.0.113.7:51234", map[string][]string{"X-Real-Ip": {"1.2.3.4"}, "X-Forwarded-For": {"1.2.3.4"}}, "203.0.113.7"},
+		{"受信任的代理设置 X-Real-IP", "127.0.0.1:80", map[string][]string{"X-Real-Ip": {"198.51.100.9"}}, "198.51.100.9"},
+		{"X-Forwarded-For 中最右侧不受信任的地址", "127.0.0.1:80", map[string][]string{"X-Forwarded-For": {"1.2.3.4, 198.51.100.9, 10.1.2.3"}}, "198.51.100.9"},
+		{"多个 X-Forwarded-For 请求头", "10.0.0.2:80", map[string][]string{"X-Forwarded-For": {"1.2.3.4", "198.51.100.9"}}, "198.51.100.9"},
+		{"X-Forwarded-For 优先于 X-Real-IP", "127.0.0.1:80", map[string][]string{"X-Forwarded-For": {"198.51.100.9"}, "X-Real-Ip": {"1.2.3.4"}}, "198.51.100.9"},
+		{"X-Forwarded-For 中格式错误的地址", "127.0.0.1:80", map[string][]string{"X-Forwarded-For": {"198.51.100.9, not-an-ip, 10.1.2.3"}}, "10.1.2.3"},
+		{"受信任的代理没有设置请求头", "[::1]:80", nil, "::1"},
+		{"IPv6 地址规范化", "[2001:DB8::0001]:443", nil, "2001:db8::1"},
+		{"对端地址无法解析", "unknown", map[string][]string{"X-Real-Ip": {"1.2.3.4"}}, ""},
+	}
+	for _, tt := range tests {
+		ip, _ := tp.ClientInfo(httpContext(tt.remote, tt.header))
+		if ip != tt.ip {
+			t.Errorf("%s: ip = %q, want %q", tt.name, ip, tt.ip)
+		}
+	}
+}
+
+func TestClientInfoGRPC(t *testing.T) {
+	tp, err := NewTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		remote string
+		header map[string][]string
+		ip     string
+		device string
+	}{
+		{"直连", "203.0.113.7:51234", map[string][]string{"X-Device-Id": {" device-1 "}}, "203.0.113.7", "device-1"},
+		{"直连时忽略伪造的请求头", "203.0.113.7:51234", map[string][]string{"X-Forwarded-For": {"1.2.3.4"}}, "203.0.113.7", ""},
+		{"受信任的代理", "10.0.0.2:51234", map[string][]string{"X-Forwarded-For": {"198.51.100.9"}}, "198.51.100.9", ""},
+	}
+	for _, tt := range tests {
+		addr, err := net.ResolveTCPAddr("tcp", tt.remote)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		ctx = transport.NewServerContext(ctx, &grpcTransport{header: headerCarrier(tt.header), reply: headerCarrier{}})
+		ip, device := tp.ClientInfo(ctx)
+		if ip != tt.ip || device != tt.device {
+			t.Errorf("%s: ClientInfo = %q, %q, want %q, %q", tt.name, ip, device, tt.ip, tt.device)
+		}
+	}
+}
+
+func TestNewTrustedProxies(t *testing.T) {
+	tests := []struct {
+		proxies []string
+		ok      bool
+	}{
+		{nil, true},
+		{[]string{"127.0.0.1", " 10.0.0.0/8 ", "fd00::/8", "::1"}, true},
+		{[]string{"localhost"}, false},
+		{[]string{"10.0.0.0/33"}, false},
+	}
+	for _, tt := range tests {
+		if _, err := NewTrustedProxies(tt.proxies); (err == nil) != tt.ok {
+			t.Errorf("NewTrustedProxies(%v) error = %v, want ok %t", tt.proxies, err, tt.ok)
+		}
+	}
+	// 没有受信任的代理时总是使用对端地址
+	var tp *TrustedProxies
+	if ip, _ := tp.ClientInfo(httpContext("127.0.0.1:80", map[string][]string{"X-Real-Ip": {"1.2.3.4"}})); ip != "127.0.0.1" {
+		t.Fatalf("ip = %q, want 127.0.0.1", ip)
+	}
+}
+
+type telephoneReq struct{ telephone string }
+
+func (r *telephoneReq) GetTelephone() string { return r.telephone }
+
+func TestVerifyCode(t *testing.T) {
+	tooFrequent := errors.New(429, "VERIFY_CODE_TOO_FREQUENT", "too frequent").WithMetadata(map[string]string{"retry_after": "42"})
+	tests := []struct {
+		name       string
+		req        interface{}
+		allowErr   error
+		wantCalled bool
+		wantRetry  string
+	}{
+		{"通过", &telephoneReq{"13800000000"}, nil, true, ""},
+		{"被拒绝", &telephoneReq{"13800000000"}, tooFrequent, false, "42"},
+		{"没有号码的请求不限制", struct{}{}, tooFrequent, true, ""},
+		{"格式错误的号码不计数", &telephoneReq{"12345"}, tooFrequent, true, ""},
+	}
+	valid := func(subject string) bool { return len(subject) == 11 }
+	for _, tt := range tests {
+		var gotSubject, gotIP string
+		allowed := false
+		allow := func(ctx context.Context, subject, ip, device string) error {
+			allowed = true
+			gotSubject, gotIP = subject, ip
+			return tt.allowErr
+		}
+		ctx := httpContext("203.0.113.7:51234", nil)
+		called := false
+		_, err := VerifyCode(allow, valid, nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})(ctx, tt.req)
+		if called != tt.wantCalled {
+			t.Fatalf("%s: handler called %t, want %t (err %v)", tt.name, called, tt.wantCalled, err)
+		}
+		if r, ok := tt.req.(*telephoneReq); ok && allowed != valid(r.telephone) {
+			t.Fatalf("%s: allow called %t", tt.name, allowed)
+		}
+		tr, _ := transport.FromServerContext(ctx)
+		if got := tr.ReplyHeader().Get("Retry-After"); got != tt.wantRetry {
+			t.Fatalf("%s: Retry-After = %q, want %q", tt.name, got, tt.wantRetry)
+		}
+		if tt.allowErr != nil && tt.wantCalled == false && (!errors.Is(err, tt.allowErr) || gotSubject != "13800000000" || gotIP != "203.0.113.7") {
+			t.Fatalf("%s: error %v, subject %q, ip %q", tt.name, err, gotSubject, gotIP)
+		}
+	}
+}
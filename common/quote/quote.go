@@ -0,0 +1,65 @@
+// Package quote 报价令牌，Valuation 服务签发，Customer 服务下单时使用同一密钥离线校验
+package quote
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 报价令牌的 sub
+const Subject = "price-quote"
+
+var (
+	ErrExpired = errors.New("quote token expired")
+	ErrInvalid = errors.New("quote token invalid")
+)
+
+// 报价令牌的 payload，签发和校验共用
+type Claims struct {
+	Origin      string  `json:"org"`
+	Destination string  `json:"dst"`
+	City        string  `json:"city"`
+	Price       int64   `json:"price"`
+	Currency    string  `json:"cur"`
+	RuleID      uint    `json:"rule"`
+	RuleVersion int64   `json:"rule_ver"` // 规则版本的生效时间，unix timestamp
+	Surge       float64 `json:"surge"`
+	Tolls       int64   `json:"tolls,omitempty"`  // 报价路线的过路费，已包含在价格中
+	Approximate bool    `json:"approx,omitempty"` // 按估算的距离和时长计价
+	jwt.RegisteredClaims
+}
+
+// 使用 HS256 签名，sub 固定为 Subject
+func Sign(claims *Claims, secret string) (string, error) {
+	if secret == "" {
+		return "", ErrInvalid
+	}
+	claims.Subject = Subject
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// 校验签名、签发方、有效期，报价id（jti）和价格不能为空
+// 过期时返回 ErrExpired，其他错误返回 ErrInvalid
+func Parse(token, secret, issuer string) (*Claims, error) {
+	if secret == "" {
+		return nil, ErrInvalid
+	}
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(strings.TrimSpace(token), claims, func(t *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithSubject(Subject),
+		jwt.WithExpirationRequired(),
+	)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, ErrExpired
+	}
+	if err != nil || claims.ID == "" || claims.Price <= 0 {
+		return nil, ErrInvalid
+	}
+	return claims, nil
+}
@@ -0,0 +1,84 @@
+package quote
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestParse(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	const issuer = "LaoMaDJ-Valuation"
+	claims := func(f func(c *Claims)) *Claims {
+		c := &Claims{
+			Origin:      "116.397128,39.916527",
+			Destination: "116.481028,39.989643",
+			City:        "110000",
+			Price:       4200,
+			Currency:    "CNY",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    issuer,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				ID:        "quote-1",
+			},
+		}
+		if f != nil {
+			f(c)
+		}
+		return c
+	}
+	sign := func(c *Claims, secret string) string {
+		token, err := Sign(c, secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	// 其他 sub 的 token，例如顾客的登录 token
+	other, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   "customer-authentication",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		ID:        "1",
+	}).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		token   string
+		secret  string
+		wantErr error
+	}{
+		{"合法", sign(claims(nil), secret), secret, nil},
+		{"前后有空白", " " + sign(claims(nil), secret) + "\n", secret, nil},
+		{"已过期", sign(claims(func(c *Claims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) }), secret), secret, ErrExpired},
+		{"缺少有效期", sign(claims(func(c *Claims) { c.ExpiresAt = nil }), secret), secret, ErrInvalid},
+		{"密钥错误", sign(claims(nil), "another secret"), secret, ErrInvalid},
+		{"签发方错误", sign(claims(func(c *Claims) { c.Issuer = "other" }), secret), secret, ErrInvalid},
+		{"缺少报价id", sign(claims(func(c *Claims) { c.ID = "" }), secret), secret, ErrInvalid},
+		{"价格为 0", sign(claims(func(c *Claims) { c.Price = 0 }), secret), secret, ErrInvalid},
+		{"不是报价令牌", other, secret, ErrInvalid},
+		{"未配置密钥", sign(claims(nil), secret), "", ErrInvalid},
+		{"格式错误", "not a token", secret, ErrInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.token, tt.secret, issuer)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Price != 4200 || got.ID != "quote-1" || got.City != "110000") {
+				t.Fatalf("claims = %+v", got)
+			}
+		})
+	}
+}
+
+func TestSignWithoutSecret(t *testing.T) {
+	if _, err := Sign(&Claims{Price: 1}, ""); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("error = %v, want ErrInvalid", err)
+	}
+}
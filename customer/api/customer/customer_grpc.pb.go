@@ -19,10 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Customer_GetVerifyCode_FullMethodName = "/api.customer.Customer/GetVerifyCode"
-	Customer_Login_FullMethodName         = "/api.customer.Customer/Login"
-	Customer_Logout_FullMethodName        = "/api.customer.Customer/Logout"
-	Customer_EstimatePrice_FullMethodName = "/api.customer.Customer/EstimatePrice"
+	Customer_GetVerifyCode_FullMethodName  = "/api.customer.Customer/GetVerifyCode"
+	Customer_Login_FullMethodName          = "/api.customer.Customer/Login"
+	Customer_Logout_FullMethodName         = "/api.customer.Customer/Logout"
+	Customer_Geocode_FullMethodName        = "/api.customer.Customer/Geocode"
+	Customer_ReverseGeocode_FullMethodName = "/api.customer.Customer/ReverseGeocode"
+	Customer_PlaceSuggest_FullMethodName   = "/api.customer.Customer/PlaceSuggest"
+	Customer_EstimatePrice_FullMethodName  = "/api.customer.Customer/EstimatePrice"
+	Customer_CreateOrder_FullMethodName    = "/api.customer.Customer/CreateOrder"
+	Customer_CancelOrder_FullMethodName    = "/api.customer.Customer/CancelOrder"
+	Customer_GetOrder_FullMethodName       = "/api.customer.Customer/GetOrder"
+	Customer_ListOrders_FullMethodName     = "/api.customer.Customer/ListOrders"
+	Customer_PayOrder_FullMethodName       = "/api.customer.Customer/PayOrder"
 )
 
 // CustomerClient is the client API for Customer service.
@@ -36,7 +44,24 @@ type CustomerClient interface {
 	// 退出登陆
 	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
 	// 价格预估
+	// 地理编码，地址转换为坐标
+	Geocode(ctx context.Context, in *GeocodeReq, opts ...grpc.CallOption) (*PlacesResp, error)
+	// 逆地理编码，坐标转换为地址
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeReq, opts ...grpc.CallOption) (*ReverseGeocodeResp, error)
+	// 输入提示
+	PlaceSuggest(ctx context.Context, in *PlaceSuggestReq, opts ...grpc.CallOption) (*PlacesResp, error)
+	// 起终点为 lng,lat 坐标或地址
 	EstimatePrice(ctx context.Context, in *EstimatePriceReq, opts ...grpc.CallOption) (*EstimatePriceResp, error)
+	// 下单，价格由服务端预估
+	CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*CreateOrderResp, error)
+	// 取消订单
+	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error)
+	// 订单详情
+	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error)
+	// 我的订单
+	ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error)
+	// 支付订单
+	PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderResp, error)
 }
 
 type customerClient struct {
@@ -77,6 +102,36 @@ func (c *customerClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc
 	return out, nil
 }
 
+func (c *customerClient) Geocode(ctx context.Context, in *GeocodeReq, opts ...grpc.CallOption) (*PlacesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacesResp)
+	err := c.cc.Invoke(ctx, Customer_Geocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeReq, opts ...grpc.CallOption) (*ReverseGeocodeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseGeocodeResp)
+	err := c.cc.Invoke(ctx, Customer_ReverseGeocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) PlaceSuggest(ctx context.Context, in *PlaceSuggestReq, opts ...grpc.CallOption) (*PlacesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacesResp)
+	err := c.cc.Invoke(ctx, Customer_PlaceSuggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerClient) EstimatePrice(ctx context.Context, in *EstimatePriceReq, opts ...grpc.CallOption) (*EstimatePriceResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EstimatePriceResp)
@@ -87,6 +142,56 @@ func (c *customerClient) EstimatePrice(ctx context.Context, in *EstimatePriceReq
 	return out, nil
 }
 
+func (c *customerClient) CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...grpc.CallOption) (*CreateOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrderResp)
+	err := c.cc.Invoke(ctx, Customer_CreateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResp)
+	err := c.cc.Invoke(ctx, Customer_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderResp)
+	err := c.cc.Invoke(ctx, Customer_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResp)
+	err := c.cc.Invoke(ctx, Customer_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) PayOrder(ctx context.Context, in *PayOrderReq, opts ...grpc.CallOption) (*PayOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayOrderResp)
+	err := c.cc.Invoke(ctx, Customer_PayOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServer is the server API for Customer service.
 // All implementations must embed UnimplementedCustomerServer
 // for forward compatibility
@@ -98,7 +203,24 @@ type CustomerServer interface {
 	// 退出登陆
 	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
 	// 价格预估
+	// 地理编码，地址转换为坐标
+	Geocode(context.Context, *GeocodeReq) (*PlacesResp, error)
+	// 逆地理编码，坐标转换为地址
+	ReverseGeocode(context.Context, *ReverseGeocodeReq) (*ReverseGeocodeResp, error)
+	// 输入提示
+	PlaceSuggest(context.Context, *PlaceSuggestReq) (*PlacesResp, error)
+	// 起终点为 lng,lat 坐标或地址
 	EstimatePrice(context.Context, *EstimatePriceReq) (*EstimatePriceResp, error)
+	// 下单，价格由服务端预估
+	CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderResp, error)
+	// 取消订单
+	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error)
+	// 订单详情
+	GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error)
+	// 我的订单
+	ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error)
+	// 支付订单
+	PayOrder(context.Context, *PayOrderReq) (*PayOrderResp, error)
 	mustEmbedUnimplementedCustomerServer()
 }
 
@@ -115,9 +237,33 @@ func (UnimplementedCustomerServer) Login(context.Context, *LoginReq) (*LoginResp
 func (UnimplementedCustomerServer) Logout(context.Context, *LogoutReq) (*LogoutResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedCustomerServer) Geocode(context.Context, *GeocodeReq) (*PlacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Geocode not implemented")
+}
+func (UnimplementedCustomerServer) ReverseGeocode(context.Context, *ReverseGeocodeReq) (*ReverseGeocodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
+func (UnimplementedCustomerServer) PlaceSuggest(context.Context, *PlaceSuggestReq) (*PlacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceSuggest not implemented")
+}
 func (UnimplementedCustomerServer) EstimatePrice(context.Context, *EstimatePriceReq) (*EstimatePriceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimatePrice not implemented")
 }
+func (UnimplementedCustomerServer) CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedCustomerServer) CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedCustomerServer) GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedCustomerServer) ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedCustomerServer) PayOrder(context.Context, *PayOrderReq) (*PayOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+}
 func (UnimplementedCustomerServer) mustEmbedUnimplementedCustomerServer() {}
 
 // UnsafeCustomerServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +331,60 @@ func _Customer_Logout_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Customer_Geocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).Geocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_Geocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).Geocode(ctx, req.(*GeocodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_ReverseGeocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ReverseGeocode(ctx, req.(*ReverseGeocodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_PlaceSuggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceSuggestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).PlaceSuggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_PlaceSuggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).PlaceSuggest(ctx, req.(*PlaceSuggestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Customer_EstimatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EstimatePriceReq)
 	if err := dec(in); err != nil {
@@ -203,6 +403,96 @@ func _Customer_EstimatePrice_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Customer_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).CreateOrder(ctx, req.(*CreateOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).CancelOrder(ctx, req.(*CancelOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).GetOrder(ctx, req.(*GetOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ListOrders(ctx, req.(*ListOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).PayOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Customer_PayOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).PayOrder(ctx, req.(*PayOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Customer_ServiceDesc is the grpc.ServiceDesc for Customer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,10 +512,42 @@ var Customer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _Customer_Logout_Handler,
 		},
+		{
+			MethodName: "Geocode",
+			Handler:    _Customer_Geocode_Handler,
+		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _Customer_ReverseGeocode_Handler,
+		},
+		{
+			MethodName: "PlaceSuggest",
+			Handler:    _Customer_PlaceSuggest_Handler,
+		},
 		{
 			MethodName: "EstimatePrice",
 			Handler:    _Customer_EstimatePrice_Handler,
 		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _Customer_CreateOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Customer_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Customer_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _Customer_ListOrders_Handler,
+		},
+		{
+			MethodName: "PayOrder",
+			Handler:    _Customer_PayOrder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "customer.proto",
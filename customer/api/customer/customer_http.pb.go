@@ -19,20 +19,45 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationCustomerCancelOrder = "/api.customer.Customer/CancelOrder"
+const OperationCustomerCreateOrder = "/api.customer.Customer/CreateOrder"
 const OperationCustomerEstimatePrice = "/api.customer.Customer/EstimatePrice"
+const OperationCustomerGeocode = "/api.customer.Customer/Geocode"
+const OperationCustomerGetOrder = "/api.customer.Customer/GetOrder"
 const OperationCustomerGetVerifyCode = "/api.customer.Customer/GetVerifyCode"
+const OperationCustomerListOrders = "/api.customer.Customer/ListOrders"
 const OperationCustomerLogin = "/api.customer.Customer/Login"
 const OperationCustomerLogout = "/api.customer.Customer/Logout"
+const OperationCustomerPayOrder = "/api.customer.Customer/PayOrder"
+const OperationCustomerPlaceSuggest = "/api.customer.Customer/PlaceSuggest"
+const OperationCustomerReverseGeocode = "/api.customer.Customer/ReverseGeocode"
 
 type CustomerHTTPServer interface {
-	// EstimatePrice 价格预估
+	// CancelOrder 取消订单
+	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error)
+	// CreateOrder 下单，价格由服务端预估
+	CreateOrder(context.Context, *CreateOrderReq) (*CreateOrderResp, error)
+	// EstimatePrice 起终点为 lng,lat 坐标或地址
 	EstimatePrice(context.Context, *EstimatePriceReq) (*EstimatePriceResp, error)
+	// Geocode 价格预估
+	// 地理编码，地址转换为坐标
+	Geocode(context.Context, *GeocodeReq) (*PlacesResp, error)
+	// GetOrder 订单详情
+	GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error)
 	// GetVerifyCode 获取验证码
 	GetVerifyCode(context.Context, *GetVerifyCodeReq) (*GetVerifyCodeResp, error)
+	// ListOrders 我的订单
+	ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error)
 	// Login 登录
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	// Logout 退出登陆
 	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
+	// PayOrder 支付订单
+	PayOrder(context.Context, *PayOrderReq) (*PayOrderResp, error)
+	// PlaceSuggest 输入提示
+	PlaceSuggest(context.Context, *PlaceSuggestReq) (*PlacesResp, error)
+	// ReverseGeocode 逆地理编码，坐标转换为地址
+	ReverseGeocode(context.Context, *ReverseGeocodeReq) (*ReverseGeocodeResp, error)
 }
 
 func RegisterCustomerHTTPServer(s *http.Server, srv CustomerHTTPServer) {
@@ -40,7 +65,15 @@ func RegisterCustomerHTTPServer(s *http.Server, srv CustomerHTTPServer) {
 	r.GET("/customer/get-verify-code/{telephone}", _Customer_GetVerifyCode0_HTTP_Handler(srv))
 	r.POST("/customer/login", _Customer_Login0_HTTP_Handler(srv))
 	r.GET("/customer/logout", _Customer_Logout0_HTTP_Handler(srv))
+	r.GET("/customer/geocode", _Customer_Geocode0_HTTP_Handler(srv))
+	r.GET("/customer/reverse-geocode", _Customer_ReverseGeocode0_HTTP_Handler(srv))
+	r.GET("/customer/place-suggest", _Customer_PlaceSuggest0_HTTP_Handler(srv))
 	r.GET("/customer/estimate-price/{origin}/{destination}", _Customer_EstimatePrice0_HTTP_Handler(srv))
+	r.POST("/customer/order", _Customer_CreateOrder0_HTTP_Handler(srv))
+	r.POST("/customer/order/{order_id}/cancel", _Customer_CancelOrder0_HTTP_Handler(srv))
+	r.GET("/customer/order/{order_id}", _Customer_GetOrder0_HTTP_Handler(srv))
+	r.GET("/customer/orders", _Customer_ListOrders0_HTTP_Handler(srv))
+	r.POST("/customer/order/{order_id}/pay", _Customer_PayOrder0_HTTP_Handler(srv))
 }
 
 func _Customer_GetVerifyCode0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
@@ -106,6 +139,63 @@ func _Customer_Logout0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _Customer_Geocode0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GeocodeReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerGeocode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Geocode(ctx, req.(*GeocodeReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PlacesResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_ReverseGeocode0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReverseGeocodeReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerReverseGeocode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReverseGeocode(ctx, req.(*ReverseGeocodeReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReverseGeocodeResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_PlaceSuggest0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PlaceSuggestReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerPlaceSuggest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PlaceSuggest(ctx, req.(*PlaceSuggestReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PlacesResp)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Customer_EstimatePrice0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in EstimatePriceReq
@@ -128,11 +218,132 @@ func _Customer_EstimatePrice0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http
 	}
 }
 
+func _Customer_CreateOrder0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerCreateOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOrder(ctx, req.(*CreateOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_CancelOrder0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerCancelOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelOrder(ctx, req.(*CancelOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CancelOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_GetOrder0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOrderReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerGetOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOrder(ctx, req.(*GetOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_ListOrders0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOrdersReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerListOrders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOrders(ctx, req.(*ListOrdersReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOrdersResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Customer_PayOrder0_HTTP_Handler(srv CustomerHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PayOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCustomerPayOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PayOrder(ctx, req.(*PayOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PayOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CustomerHTTPClient interface {
+	CancelOrder(ctx context.Context, req *CancelOrderReq, opts ...http.CallOption) (rsp *CancelOrderResp, err error)
+	CreateOrder(ctx context.Context, req *CreateOrderReq, opts ...http.CallOption) (rsp *CreateOrderResp, err error)
 	EstimatePrice(ctx context.Context, req *EstimatePriceReq, opts ...http.CallOption) (rsp *EstimatePriceResp, err error)
+	Geocode(ctx context.Context, req *GeocodeReq, opts ...http.CallOption) (rsp *PlacesResp, err error)
+	GetOrder(ctx context.Context, req *GetOrderReq, opts ...http.CallOption) (rsp *GetOrderResp, err error)
 	GetVerifyCode(ctx context.Context, req *GetVerifyCodeReq, opts ...http.CallOption) (rsp *GetVerifyCodeResp, err error)
+	ListOrders(ctx context.Context, req *ListOrdersReq, opts ...http.CallOption) (rsp *ListOrdersResp, err error)
 	Login(ctx context.Context, req *LoginReq, opts ...http.CallOption) (rsp *LoginResp, err error)
 	Logout(ctx context.Context, req *LogoutReq, opts ...http.CallOption) (rsp *LogoutResp, err error)
+	PayOrder(ctx context.Context, req *PayOrderReq, opts ...http.CallOption) (rsp *PayOrderResp, err error)
+	PlaceSuggest(ctx context.Context, req *PlaceSuggestReq, opts ...http.CallOption) (rsp *PlacesResp, err error)
+	ReverseGeocode(ctx context.Context, req *ReverseGeocodeReq, opts ...http.CallOption) (rsp *ReverseGeocodeResp, err error)
 }
 
 type CustomerHTTPClientImpl struct {
@@ -143,6 +354,32 @@ func NewCustomerHTTPClient(client *http.Client) CustomerHTTPClient {
 	return &CustomerHTTPClientImpl{client}
 }
 
+func (c *CustomerHTTPClientImpl) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...http.CallOption) (*CancelOrderResp, error) {
+	var out CancelOrderResp
+	pattern := "/customer/order/{order_id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCustomerCancelOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CustomerHTTPClientImpl) CreateOrder(ctx context.Context, in *CreateOrderReq, opts ...http.CallOption) (*CreateOrderResp, error) {
+	var out CreateOrderResp
+	pattern := "/customer/order"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCustomerCreateOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CustomerHTTPClientImpl) EstimatePrice(ctx context.Context, in *EstimatePriceReq, opts ...http.CallOption) (*EstimatePriceResp, error) {
 	var out EstimatePriceResp
 	pattern := "/customer/estimate-price/{origin}/{destination}"
@@ -156,6 +393,32 @@ func (c *CustomerHTTPClientImpl) EstimatePrice(ctx context.Context, in *Estimate
 	return &out, nil
 }
 
+func (c *CustomerHTTPClientImpl) Geocode(ctx context.Context, in *GeocodeReq, opts ...http.CallOption) (*PlacesResp, error) {
+	var out PlacesResp
+	pattern := "/customer/geocode"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCustomerGeocode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CustomerHTTPClientImpl) GetOrder(ctx context.Context, in *GetOrderReq, opts ...http.CallOption) (*GetOrderResp, error) {
+	var out GetOrderResp
+	pattern := "/customer/order/{order_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCustomerGetOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CustomerHTTPClientImpl) GetVerifyCode(ctx context.Context, in *GetVerifyCodeReq, opts ...http.CallOption) (*GetVerifyCodeResp, error) {
 	var out GetVerifyCodeResp
 	pattern := "/customer/get-verify-code/{telephone}"
@@ -169,6 +432,19 @@ func (c *CustomerHTTPClientImpl) GetVerifyCode(ctx context.Context, in *GetVerif
 	return &out, nil
 }
 
+func (c *CustomerHTTPClientImpl) ListOrders(ctx context.Context, in *ListOrdersReq, opts ...http.CallOption) (*ListOrdersResp, error) {
+	var out ListOrdersResp
+	pattern := "/customer/orders"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCustomerListOrders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *CustomerHTTPClientImpl) Login(ctx context.Context, in *LoginReq, opts ...http.CallOption) (*LoginResp, error) {
 	var out LoginResp
 	pattern := "/customer/login"
@@ -194,3 +470,42 @@ func (c *CustomerHTTPClientImpl) Logout(ctx context.Context, in *LogoutReq, opts
 	}
 	return &out, nil
 }
+
+func (c *CustomerHTTPClientImpl) PayOrder(ctx context.Context, in *PayOrderReq, opts ...http.CallOption) (*PayOrderResp, error) {
+	var out PayOrderResp
+	pattern := "/customer/order/{order_id}/pay"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCustomerPayOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CustomerHTTPClientImpl) PlaceSuggest(ctx context.Context, in *PlaceSuggestReq, opts ...http.CallOption) (*PlacesResp, error) {
+	var out PlacesResp
+	pattern := "/customer/place-suggest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCustomerPlaceSuggest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CustomerHTTPClientImpl) ReverseGeocode(ctx context.Context, in *ReverseGeocodeReq, opts ...http.CallOption) (*ReverseGeocodeResp, error) {
+	var out ReverseGeocodeResp
+	pattern := "/customer/reverse-geocode"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCustomerReverseGeocode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
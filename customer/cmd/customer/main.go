@@ -2,12 +2,14 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"customer/internal/conf"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -61,6 +63,8 @@ func main() {
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
+			// 环境变量，去掉 LAOMADJ_ 前缀后用于替换配置文件中的 ${NAME:default}
+			env.NewSource("LAOMADJ_"),
 		),
 	)
 	defer c.Close()
@@ -73,8 +77,12 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 密钥没有默认值，未配置或过短时拒绝启动
+	if err := checkSecrets(&bc); err != nil {
+		panic(err)
+	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Service, bc.Auth, bc.Quote, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -85,3 +93,20 @@ func main() {
 		panic(err)
 	}
 }
+
+// HS256 密钥的最小长度
+const secretLenMin = 32
+
+// 检查配置中的密钥
+func checkSecrets(bc *conf.Bootstrap) error {
+	if len(bc.GetAuth().GetSecret()) < secretLenMin {
+		return fmt.Errorf("auth.secret must be at least %d characters, set LAOMADJ_CUSTOMER_AUTH_SECRET", secretLenMin)
+	}
+	if len(bc.GetAuth().GetServiceSecret()) < secretLenMin {
+		return fmt.Errorf("auth.service_secret must be at least %d characters, set LAOMADJ_SERVICE_SECRET", secretLenMin)
+	}
+	if len(bc.GetQuote().GetSecret()) < secretLenMin {
+		return fmt.Errorf("quote.secret must be at least %d characters, set LAOMADJ_QUOTE_SECRET", secretLenMin)
+	}
+	return nil
+}
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, confService *conf.Service, auth *conf.Auth, quote *conf.Quote, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -32,12 +32,49 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	customerData := data.NewCustomerData(dataData)
-	customerBiz := biz.NewCustomerBiz()
-	customerService := service.NewCustomerService(customerData, customerBiz)
-	httpServer := server.NewHTTPServer(confServer, greeterService, customerService, logger)
+	customerData := data.NewCustomerData(dataData, auth)
+	clientFactory, cleanup2, err := data.NewClientFactory(confService, auth, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	valuationClient, err := data.NewValuationClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	orderClient, err := data.NewOrderClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mapServiceClient, err := data.NewMapServiceClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	quoteInterface := data.NewQuoteInterface(dataData)
+	customerBiz := biz.NewCustomerBiz(valuationClient, orderClient, mapServiceClient, quote, quoteInterface)
+	verifyCodeClient, err := data.NewVerifyCodeClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	customerService := service.NewCustomerService(customerData, customerBiz, verifyCodeClient)
+	trustedProxies, err := server.NewTrustedProxies(confServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	httpServer := server.NewHTTPServer(confServer, auth, greeterService, customerService, verifyCodeClient, trustedProxies, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -1,8 +1,9 @@
 module customer
 
-go 1.19
+go 1.21
 
 require (
+	common v0.0.0
 	github.com/go-kratos/kratos/contrib/registry/consul/v2 v2.0.0-20240615052815-46362d1a360d
 	github.com/go-kratos/kratos/v2 v2.7.3
 	github.com/golang-jwt/jwt/v5 v5.2.1
@@ -58,3 +59,5 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240624140628-dc46fd24d27d // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace common => ../common
@@ -2,17 +2,14 @@ package biz
 
 import (
 	"context"
+	"customer/api/mapService"
+	"customer/api/order"
 	"customer/api/valuation"
+	"customer/internal/conf"
 	"database/sql"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"github.com/hashicorp/consul/api"
 	"gorm.io/gorm"
 )
 
-const CustomerSecret = "yourSecretKey"
-const CustomerDuration = 2 * 30 * 24 * 3600
-
 // Customer 模型
 type Customer struct {
 	// 业务逻辑
@@ -38,47 +35,34 @@ type CustomerToken struct {
 	TokenCreatedAt sql.NullTime `gorm:"" json:"token_created_at"`
 }
 
-type CustomerBiz struct{}
-
-func NewCustomerBiz() *CustomerBiz {
-	return &CustomerBiz{}
+type CustomerBiz struct {
+	vc valuation.ValuationClient
+	oc order.OrderClient
+	mc mapService.MapServiceClient
+	// 报价令牌的校验配置
+	quote *conf.Quote
+	qi    QuoteInterface
 }
 
-func (cb *CustomerBiz) GetEstimatePrice(ctx context.Context, origin, destination string) (int64, error) {
-	// 一，grpc 获取
-	// 1.获取consul客户端
-	consulConfig := api.DefaultConfig()
-	consulConfig.Address = "192.168.43.144:8500"
-	consulClient, err := api.NewClient(consulConfig)
-	// 2.获取服务发现管理器
-	dis := consul.New(consulClient)
-	if err != nil {
-		return 0, err
-	}
-	// 2.1,连接目标grpc服务器,并使用Valuation服务
-	endpoint := "discovery:///Valuation"
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint(endpoint), // 目标服务的名字
-		// 使用服务发现
-		grpc.WithDiscovery(dis),
-	)
-	if err != nil {
-		return 0, nil
+// CustomerBiz 构造器，上游服务的客户端由 wire 注入，所有请求共用
+func NewCustomerBiz(vc valuation.ValuationClient, oc order.OrderClient, mc mapService.MapServiceClient, quote *conf.Quote, qi QuoteInterface) *CustomerBiz {
+	return &CustomerBiz{
+		vc:    vc,
+		oc:    oc,
+		mc:    mc,
+		quote: quote,
+		qi:    qi,
 	}
-	//关闭
-	defer func() {
-		_ = conn.Close()
-	}()
+}
 
-	// 2.2,发送获取费用请求
-	client := valuation.NewValuationClient(conn) // 创建客户端
-	reply, err := client.GetEstimatePrice(context.Background(), &valuation.GetEstimatePriceReq{
+// 预估价格，city 为空时由 Valuation 根据起点确定，departureAt 为 0 时使用当前时间
+func (cb *CustomerBiz) GetEstimatePrice(ctx context.Context, origin, destination, city string, departureAt int64, strategy string) (*valuation.GetEstimatePriceReply, error) {
+	// grpc 获取
+	return cb.vc.GetEstimatePrice(ctx, &valuation.GetEstimatePriceReq{
 		Origin:      origin,
 		Destination: destination,
+		City:        city,
+		DepartureAt: departureAt,
+		Strategy:    strategy,
 	})
-	if err != nil {
-		return 0, err
-	}
-	return reply.Price, nil
 }
@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"customer/api/order"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+// 订单列表中日期条件的格式
+const OrderDateLayout = "2006-01-02"
+
+var (
+	ErrOrderParam     = errors.BadRequest("ORDER_PARAM_ERROR", "order param error")
+	ErrOrderForbidden = errors.Forbidden("ORDER_FORBIDDEN", "order does not belong to the operator")
+)
+
+// 下单，价格由服务端通过 Valuation 预估，不信任客户端传入的价格
+// 传入报价令牌时离线校验后按报价计价（顾客看到的价格和动态调价倍数），起终点需与报价一致，
+// 不再调用 Valuation 和 Map 服务；未传入时先预估，使用预估签发的报价令牌
+// 报价过期时需要重新预估，同一报价只能下单一次，Order 服务同样校验报价令牌并以其中的价格为准
+func (cb *CustomerBiz) CreateOrder(ctx context.Context, customerID uint, origin, destination, city, quoteToken string) (*order.OrderInfo, error) {
+	origin, destination, city = strings.TrimSpace(origin), strings.TrimSpace(destination), strings.TrimSpace(city)
+	if origin == "" || destination == "" {
+		return nil, ErrOrderParam
+	}
+	var err error
+	if quoteToken == "" {
+		// 起终点为地址时先转换为坐标
+		if origin, _, err = cb.Locate(ctx, origin, city); err != nil {
+			return nil, err
+		}
+		if destination, _, err = cb.Locate(ctx, destination, city); err != nil {
+			return nil, err
+		}
+		estimate, err := cb.GetEstimatePrice(ctx, origin, destination, city, 0, "")
+		if err != nil {
+			return nil, err
+		}
+		quoteToken = estimate.QuoteToken
+	}
+	quote, err := cb.VerifyQuote(quoteToken)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Origin != origin || quote.Destination != destination {
+		return nil, ErrQuoteMismatch
+	}
+	if err := cb.claimQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+	info, err := orderInfo(cb.oc.CreateOrder(ctx, &order.CreateOrderReq{
+		CustomerId:  uint64(customerID),
+		Origin:      origin,
+		Destination: destination,
+		QuoteToken:  quoteToken,
+	}))
+	if err != nil {
+		// 下单失败时报价可以再次使用
+		_ = cb.qi.ReleaseQuote(context.WithoutCancel(ctx), quote.ID)
+	}
+	return info, err
+}
+
+// 取消订单
+func (cb *CustomerBiz) CancelOrder(ctx context.Context, customerID, orderID uint, reason string) (*order.OrderInfo, error) {
+	return orderInfo(cb.oc.CancelOrder(ctx, &order.CancelOrderReq{
+		Id:         uint64(orderID),
+		CustomerId: uint64(customerID),
+		Reason:     strings.TrimSpace(reason),
+	}))
+}
+
+// 订单详情，只能查看自己的订单
+func (cb *CustomerBiz) GetOrder(ctx context.Context, customerID, orderID uint) (*order.OrderInfo, error) {
+	info, err := orderInfo(cb.oc.GetOrder(ctx, &order.GetOrderReq{
+		Id: uint64(orderID),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if info.CustomerId != uint64(customerID) {
+		return nil, ErrOrderForbidden
+	}
+	return info, nil
+}
+
+// 我的订单，日期为空时不限制，结束日期当天的订单也包含在内
+func (cb *CustomerBiz) ListOrders(ctx context.Context, customerID uint, status, startDate, endDate string, page, pageSize int32) (*order.ListOrdersReply, error) {
+	req := &order.ListOrdersReq{
+		CustomerId: uint64(customerID),
+		Status:     status,
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	if startDate != "" {
+		start, err := time.ParseInLocation(OrderDateLayout, startDate, time.Local)
+		if err != nil {
+			return nil, ErrOrderParam
+		}
+		req.CreatedFrom = start.Unix()
+	}
+	if endDate != "" {
+		end, err := time.ParseInLocation(OrderDateLayout, endDate, time.Local)
+		if err != nil {
+			return nil, ErrOrderParam
+		}
+		req.CreatedTo = end.AddDate(0, 0, 1).Unix()
+	}
+	return cb.oc.ListOrders(ctx, req)
+}
+
+// 支付订单
+func (cb *CustomerBiz) PayOrder(ctx context.Context, customerID, orderID uint, amount int64) (*order.OrderInfo, error) {
+	return orderInfo(cb.oc.PayOrder(ctx, &order.PayOrderReq{
+		Id:         uint64(orderID),
+		CustomerId: uint64(customerID),
+		Amount:     amount,
+	}))
+}
+
+// 取出返回单个订单的调用结果
+func orderInfo(reply *order.OrderReply, err error) (*order.OrderInfo, error) {
+	if err != nil {
+		return nil, err
+	}
+	return reply.Order, nil
+}
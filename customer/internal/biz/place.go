@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"context"
+	"customer/api/mapService"
+	"github.com/go-kratos/kratos/v2/errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrPlaceNotFound = errors.NotFound("PLACE_NOT_FOUND", "no place matches the address")
+
+// lng,lat 格式的坐标
+var locationPattern = regexp.MustCompile(`^-?\d{1,3}(\.\d+)?,-?\d{1,2}(\.\d+)?$`)
+
+// 地理编码
+func (cb *CustomerBiz) Geocode(ctx context.Context, address, city string) ([]*mapService.Place, error) {
+	reply, err := cb.mc.Geocode(ctx, &mapService.GeocodeReq{
+		Address: strings.TrimSpace(address),
+		City:    strings.TrimSpace(city),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Places, nil
+}
+
+// 逆地理编码
+func (cb *CustomerBiz) ReverseGeocode(ctx context.Context, lng, lat float64) (*mapService.Place, error) {
+	reply, err := cb.mc.ReverseGeocode(ctx, &mapService.ReverseGeocodeReq{Lng: lng, Lat: lat})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Place, nil
+}
+
+// 输入提示
+func (cb *CustomerBiz) PlaceSuggest(ctx context.Context, keyword, city, near string) ([]*mapService.Place, error) {
+	reply, err := cb.mc.PlaceSuggest(ctx, &mapService.PlaceSuggestReq{
+		Keyword: strings.TrimSpace(keyword),
+		City:    strings.TrimSpace(city),
+		Near:    strings.TrimSpace(near),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Places, nil
+}
+
+// 起终点转换为坐标，lng,lat 格式的直接使用，返回的地点为 nil；否则作为地址，使用地理编码的第一个结果
+func (cb *CustomerBiz) Locate(ctx context.Context, s, city string) (string, *mapService.Place, error) {
+	s = strings.TrimSpace(s)
+	if location := strings.ReplaceAll(s, " ", ""); locationPattern.MatchString(location) {
+		return location, nil, nil
+	}
+	places, err := cb.Geocode(ctx, s, city)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(places) == 0 {
+		return "", nil, ErrPlaceNotFound
+	}
+	return places[0].Location, places[0], nil
+}
+
+// 坐标对应的地址，只用于展示，出错时为空
+func (cb *CustomerBiz) Address(ctx context.Context, location string) string {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return ""
+	}
+	lng, err1 := strconv.ParseFloat(parts[0], 64)
+	lat, err2 := strconv.ParseFloat(parts[1], 64)
+	if err1 != nil || err2 != nil {
+		return ""
+	}
+	place, err := cb.ReverseGeocode(ctx, lng, lat)
+	if err != nil {
+		return ""
+	}
+	return place.GetAddress()
+}
@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"common/quote"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+var (
+	ErrQuoteInvalid  = errors.BadRequest("QUOTE_INVALID", "quote token is invalid")
+	ErrQuoteExpired  = errors.BadRequest("QUOTE_EXPIRED", "报价已过期，请重新预估")
+	ErrQuoteMismatch = errors.BadRequest("QUOTE_MISMATCH", "origin or destination does not match the quote")
+	ErrQuoteUsed     = errors.Conflict("QUOTE_USED", "报价已用于下单，请重新预估")
+)
+
+// 报价令牌相关的资源操作接口
+type QuoteInterface interface {
+	// 记录报价id 已被使用，记录保留到报价过期，已被使用时返回 false
+	ClaimQuote(ctx context.Context, id string, ttl time.Duration) (bool, error)
+	// 下单失败时撤销使用记录，报价可以再次下单
+	ReleaseQuote(ctx context.Context, id string) error
+}
+
+// 离线校验报价令牌：HS256 签名、签发方和有效期，不调用 Valuation 服务
+func (cb *CustomerBiz) VerifyQuote(token string) (*quote.Claims, error) {
+	claims, err := quote.Parse(token, cb.quote.GetSecret(), cb.quote.GetIssuer())
+	if errors.Is(err, quote.ErrExpired) {
+		return nil, ErrQuoteExpired
+	}
+	if err != nil {
+		return nil, ErrQuoteInvalid
+	}
+	return claims, nil
+}
+
+// 占用报价，同一报价只能下单一次
+func (cb *CustomerBiz) claimQuote(ctx context.Context, claims *quote.Claims) error {
+	ok, err := cb.qi.ClaimQuote(ctx, claims.ID, time.Until(claims.ExpiresAt.Time))
+	if err != nil {
+		return errors.InternalServer("QUOTE_ERROR", "quote store error").WithCause(err)
+	}
+	if !ok {
+		return ErrQuoteUsed
+	}
+	return nil
+}
@@ -1,8 +1,8 @@
 package data
 
 import (
-	"context"
 	"customer/internal/biz"
+	"customer/internal/conf"
 	"database/sql"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
@@ -14,28 +14,18 @@ import (
 // customer 中与数据操作相关的代码
 type CustomerData struct {
 	data *Data
+	// token 的签发配置
+	auth *conf.Auth
 }
 
 // NewCustomerData 方法
-func NewCustomerData(data *Data) *CustomerData {
-	return &CustomerData{data: data}
+func NewCustomerData(data *Data, auth *conf.Auth) *CustomerData {
+	return &CustomerData{data: data, auth: auth}
 }
 
-// 设置验证码的方法
-func (cd CustomerData) SetVerifyCode(telephone, code string, ex int64) error {
-	// 设置key, customer-verify-code
-	status := cd.data.Rdb.Set(context.Background(), "CVC:"+telephone, code, time.Duration(ex)*time.Second)
-	if _, err := status.Result(); err != nil {
-		return err
-	}
-	return nil
-}
-
-// 获取号码对应的验证码
-func (cd CustomerData) GetVerifyCode(telephone string) string {
-	status := cd.data.Rdb.Get(context.Background(), "CVC:"+telephone)
-	code, _ := status.Result()
-	return code
+// token 的有效期
+func (cd CustomerData) TokenLife() time.Duration {
+	return cd.auth.TokenLife.AsDuration()
 }
 
 // 根据电话，获取顾客信息
@@ -68,19 +58,19 @@ func (cd CustomerData) GetCustomerByTelephone(telephone string) (*biz.Customer,
 }
 
 // 生成token并存储
-func (cd CustomerData) GenerateTokenAndSave(c *biz.Customer, duration time.Duration, secret string) (string, error) {
+func (cd CustomerData) GenerateTokenAndSave(c *biz.Customer) (string, error) {
 	// 一，生成token
 	// 处理token中的数据
 	// 标准的 JWT 的 payload（载荷，属于数据部分）
 	claims := jwt.RegisteredClaims{
 		// 签发方，签发机构
-		Issuer: "LaoMaDJ",
+		Issuer: cd.auth.Issuer,
 		// 说明
 		Subject: "customer-authentication",
 		// 签发给谁使用
 		Audience: []string{"customer", "other"},
 		// 有效期至
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(cd.TokenLife())),
 		// 何时启用
 		NotBefore: nil,
 		// 签发时间
@@ -91,7 +81,7 @@ func (cd CustomerData) GenerateTokenAndSave(c *biz.Customer, duration time.Durat
 	// 生成token，使用HS256进行签名
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	// 签名token，注意传入的是字节数组
-	signedToken, err := token.SignedString([]byte(secret))
+	signedToken, err := token.SignedString([]byte(cd.auth.Secret))
 	if err != nil {
 		return "", err
 	}
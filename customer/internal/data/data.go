@@ -14,7 +14,8 @@ import (
 
 // ProviderSet is data providers.
 // 增加 NewCustomerData 的 provider
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewCustomerData)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewCustomerData, NewQuoteInterface,
+	NewClientFactory, NewVerifyCodeClient, NewValuationClient, NewOrderClient, NewMapServiceClient)
 
 // Data .
 type Data struct {
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+	"customer/internal/biz"
+	"time"
+)
+
+type QuoteData struct {
+	data *Data
+}
+
+func NewQuoteInterface(data *Data) biz.QuoteInterface {
+	return &QuoteData{data: data}
+}
+
+// 已用于下单的报价id，保留到报价过期
+func quoteUsedKey(id string) string {
+	return "CQJ:" + id
+}
+
+// 记录报价id 已被使用，已存在时返回 false
+func (qd *QuoteData) ClaimQuote(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	// 报价即将过期时至少保留 1 秒，避免 ttl 为 0 时不过期
+	if ttl < time.Second {
+		ttl = time.Second
+	}
+	return qd.data.Rdb.SetNX(ctx, quoteUsedKey(id), 1, ttl).Result()
+}
+
+// 撤销使用记录
+func (qd *QuoteData) ReleaseQuote(ctx context.Context, id string) error {
+	return qd.data.Rdb.Del(ctx, quoteUsedKey(id)).Err()
+}
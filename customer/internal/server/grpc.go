@@ -11,6 +11,7 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
+// 只注册 Greeter，顾客接口（含获取验证码）只通过 HTTP 提供，不绕过限流和鉴权。
 func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
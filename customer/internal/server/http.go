@@ -1,10 +1,11 @@
 package server
 
 import (
+	"common/limit"
 	"context"
 	"customer/api/customer"
 	v1 "customer/api/helloworld/v1"
-	"customer/internal/biz"
+	"customer/api/verifyCode"
 	"customer/internal/conf"
 	"customer/internal/service"
 	"github.com/go-kratos/kratos/v2/log"
@@ -16,7 +17,7 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, customerService *service.CustomerService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, auth *conf.Auth, greeter *service.GreeterService, customerService *service.CustomerService, vc verifyCode.VerifyCodeClient, tp *limit.TrustedProxies, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -27,7 +28,7 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, customerServ
 			}).Build(),
 			selector.Server(
 				jwt.Server(func(token *jwtv5.Token) (interface{}, error) {
-					return []byte(biz.CustomerSecret), nil
+					return []byte(auth.Secret), nil
 				}),
 				customerJWT(customerService),
 			).Match(func(ctx context.Context, operation string) bool {
@@ -41,6 +42,9 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, customerServ
 				}
 				return true
 			}).Build(),
+			// 验证码发送的限流
+			selector.Server(verifyCodeLimit(vc, service.VerifyCodeScene, tp)).
+				Path("/api.customer.Customer/GetVerifyCode").Build(),
 		),
 	}
 	if c.Http.Network != "" {
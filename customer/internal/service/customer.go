@@ -2,120 +2,99 @@ package service
 
 import (
 	"context"
+	"customer/api/mapService"
+	"customer/api/order"
+	"customer/api/valuation"
 	"customer/api/verifyCode"
 	"customer/internal/biz"
 	"customer/internal/data"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
-	"github.com/go-kratos/kratos/v2/selector"
-	"github.com/go-kratos/kratos/v2/selector/wrr"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
 	jwtv5 "github.com/golang-jwt/jwt/v5"
-	"github.com/hashicorp/consul/api"
 	"log"
 	"regexp"
+	"strconv"
 	"time"
 
 	pb "customer/api/customer"
 )
 
+// 顾客登录验证码的场景，与验证码服务的配置一致
+const VerifyCodeScene = "customer_login"
+
+// 手机号格式
+var telephonePattern = regexp.MustCompile(`^(13\d|14[01456879]|15[0-35-9]|16[2567]|17[0-8]|18\d|19[0-35-9])\d{8}$`)
+
+// 校验手机号格式，获取验证码的限流中间件同样使用，格式错误的号码不计数
+func ValidTelephone(tel string) bool {
+	return telephonePattern.MatchString(tel)
+}
+
 type CustomerService struct {
 	pb.UnimplementedCustomerServer
 	CD *data.CustomerData
 	cb *biz.CustomerBiz
+	vc verifyCode.VerifyCodeClient
 }
 
-func NewCustomerService(cd *data.CustomerData, cb *biz.CustomerBiz) *CustomerService {
+func NewCustomerService(cd *data.CustomerData, cb *biz.CustomerBiz, vc verifyCode.VerifyCodeClient) *CustomerService {
 	return &CustomerService{
 		CD: cd,
 		cb: cb,
+		vc: vc,
 	}
 }
 
 func (s *CustomerService) GetVerifyCode(ctx context.Context, req *pb.GetVerifyCodeReq) (*pb.GetVerifyCodeResp, error) {
 	// 一，校验手机号
-	pattern := `^(13\d|14[01456879]|15[0-35-9]|16[2567]|17[0-8]|18\d|19[0-35-9])\d{8}$`
-	// 生成一个正则表达式对象
-	regexpPattern := regexp.MustCompile(pattern)
-	if !regexpPattern.MatchString(req.Telephone) {
+	if !ValidTelephone(req.Telephone) {
 		return &pb.GetVerifyCodeResp{
 			Code:    1,
 			Message: "电话号码格式错误",
 		}, nil
 	}
-	// 二，通验证码服务生成验证码（服务间通信，grpc）
-	// 使用服务发现
-	// 1.获取consul客户端
-	consulConfig := api.DefaultConfig()
-	consulConfig.Address = "192.168.43.144:8500"
-	consulClient, err := api.NewClient(consulConfig)
-	if err != nil {
-		log.Fatal(err)
-	}
-	// 2.获取服务发现管理器
-	// 创建一个新的 Consul 注册表实例
-	dis := consul.New(consulClient)
-	//selector.SetGlobalSelector(random.NewBuilder())
-	selector.SetGlobalSelector(wrr.NewBuilder())
-	//selector.SetGlobalSelector(p2c.NewBuilder())
-	//log.Println(selector.GlobalSelector())
-	// 2.1,连接目标grpc服务器
-	endpoint := "discovery:///verifyCode"
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		//grpc.WithEndpoint("localhost:9000"), // verifyCode grpc service 地址
-		grpc.WithEndpoint(endpoint), // 目标服务的名字
-		// 使用服务发现
-		grpc.WithDiscovery(dis),
-	)
-	if err != nil {
-		return &pb.GetVerifyCodeResp{
-			Code:    1,
-			Message: "验证码服务不可用",
-		}, nil
-	}
-	//关闭,跟直接用 defer conn.Close() 关闭有什么区别？没区别
-	defer func() {
-		_ = conn.Close()
-	}()
-	// 2.2,发送获取验证码请求
-	client := verifyCode.NewVerifyCodeClient(conn)
-	reply, err := client.GetVerifyCode(context.Background(), &verifyCode.GetVerifyCodeRequest{
-		Length: 6,
-		Type:   2,
+	// 二，由验证码服务签发并存储验证码（服务间通信，grpc）
+	// 使用启动时建立的长连接，不再每次请求都连接 consul 和目标服务
+	reply, err := s.vc.Issue(ctx, &verifyCode.IssueRequest{
+		Scene:   VerifyCodeScene,
+		Subject: req.Telephone,
 	})
 	if err != nil {
 		return &pb.GetVerifyCodeResp{
 			Code:    1,
-			Message: "验证码获取错误",
-		}, nil
-	}
-
-	// 三，redis的临时存储
-	const life = 60
-	if err := s.CD.SetVerifyCode(req.Telephone, reply.Code, life); err != nil {
-		return &pb.GetVerifyCodeResp{
-			Code:    1,
-			Message: "验证码存储错误（Redis的操作服务）",
+			Message: verifyCodeMessage(err, "验证码获取错误"),
 		}, nil
 	}
 	// 没有错误就返回正确结果
 	return &pb.GetVerifyCodeResp{
 		Code:           0,
 		VerifyCode:     reply.Code, //关键的一步，这样就连起来了
-		VerifyCodeTime: time.Now().Unix(),
-		VerifyCodeLife: life,
+		VerifyCodeTime: reply.IssuedAt,
+		VerifyCodeLife: int32(reply.Ttl),
 	}, nil
 }
 
+// 验证码服务错误的提示信息
+func verifyCodeMessage(err error, message string) string {
+	switch errors.Reason(err) {
+	case "VERIFY_CODE_EXPIRED":
+		return "验证码已过期，请重新获取"
+	case "VERIFY_CODE_LOCKED":
+		return "验证码错误次数过多，请稍后再试"
+	}
+	return message
+}
+
 func (s *CustomerService) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginResp, error) {
-	// 一、校验电话和验证码，从redis获取
-	code := s.CD.GetVerifyCode(req.Telephone)
-	// 将redis中的code与req中的code比较，req中的VerifyCode哪里来的？body？
-	if code == "" || code != req.VerifyCode {
+	// 一、由验证码服务校验电话和验证码，校验成功后验证码失效
+	if _, err := s.vc.Verify(ctx, &verifyCode.VerifyRequest{
+		Scene:   VerifyCodeScene,
+		Subject: req.Telephone,
+		Code:    req.VerifyCode,
+	}); err != nil {
 		return &pb.LoginResp{
 			Code:    1,
-			Message: "验证码不匹配",
+			Message: verifyCodeMessage(err, "验证码不匹配"),
 		}, nil
 	}
 
@@ -129,7 +108,7 @@ func (s *CustomerService) Login(ctx context.Context, req *pb.LoginReq) (*pb.Logi
 	}
 
 	// 三、设置token，jwt-token
-	token, err := s.CD.GenerateTokenAndSave(customer, biz.CustomerDuration*time.Second, biz.CustomerSecret)
+	token, err := s.CD.GenerateTokenAndSave(customer)
 	log.Println(err)
 	if err != nil {
 		return &pb.LoginResp{
@@ -144,7 +123,7 @@ func (s *CustomerService) Login(ctx context.Context, req *pb.LoginReq) (*pb.Logi
 		Message:       "login success",
 		Token:         token,
 		TokenCreateAt: time.Now().Unix(),
-		TokenLife:     biz.CustomerDuration,
+		TokenLife:     int32(s.CD.TokenLife().Seconds()),
 	}, nil
 }
 
@@ -168,20 +147,322 @@ func (s *CustomerService) Logout(ctx context.Context, req *pb.LogoutReq) (*pb.Lo
 	}, nil
 }
 
+func (s *CustomerService) Geocode(ctx context.Context, req *pb.GeocodeReq) (*pb.PlacesResp, error) {
+	places, err := s.cb.Geocode(ctx, req.Address, req.City)
+	if err != nil {
+		return &pb.PlacesResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.PlacesResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Places:  toPlaces(places),
+	}, nil
+}
+
+func (s *CustomerService) ReverseGeocode(ctx context.Context, req *pb.ReverseGeocodeReq) (*pb.ReverseGeocodeResp, error) {
+	place, err := s.cb.ReverseGeocode(ctx, req.Lng, req.Lat)
+	if err != nil {
+		return &pb.ReverseGeocodeResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.ReverseGeocodeResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Place:   toPlace(place),
+	}, nil
+}
+
+func (s *CustomerService) PlaceSuggest(ctx context.Context, req *pb.PlaceSuggestReq) (*pb.PlacesResp, error) {
+	places, err := s.cb.PlaceSuggest(ctx, req.Keyword, req.City, req.Near)
+	if err != nil {
+		return &pb.PlacesResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.PlacesResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Places:  toPlaces(places),
+	}, nil
+}
+
 func (s *CustomerService) EstimatePrice(ctx context.Context, req *pb.EstimatePriceReq) (*pb.EstimatePriceResp, error) {
-	price, err := s.cb.GetEstimatePrice(ctx, req.Origin, req.Destination)
+	// 起终点为地址时先转换为坐标，为坐标时查询地址用于展示
+	origin, originAddress, err := s.locate(ctx, req.Origin, req.City)
+	if err != nil {
+		return &pb.EstimatePriceResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	destination, destinationAddress, err := s.locate(ctx, req.Destination, req.City)
 	if err != nil {
 		return &pb.EstimatePriceResp{
 			Code:    1,
-			Message: err.Error(),
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	estimate, err := s.cb.GetEstimatePrice(ctx, origin, destination, req.City, req.DepartureAt, req.Strategy)
+	if err != nil {
+		return &pb.EstimatePriceResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
 		}, nil
 	}
 
 	return &pb.EstimatePriceResp{
 		Code:        0,
 		Message:     "SUCCESS",
-		Origin:      req.Origin,
-		Destination: req.Destination,
-		Price:       price,
+		Origin:      origin,
+		Destination: destination,
+		Price:       estimate.Price,
+		RuleId:      estimate.RuleId,
+		City:        estimate.City,
+		DepartureAt: estimate.DepartureAt,
+		Fare:        toFareBreakdown(estimate.Fare),
+		// 动态调价与报价
+		SurgeMultiplier: estimate.SurgeMultiplier,
+		QuoteId:         estimate.QuoteId,
+		ExpiresAt:       estimate.ExpiresAt,
+		QuoteToken:      estimate.QuoteToken,
+		// 路线
+		Strategy: estimate.Strategy,
+		Polyline: estimate.Polyline,
+		// 地址
+		OriginAddress:      originAddress,
+		DestinationAddress: destinationAddress,
+		Approximate:        estimate.Approximate,
+	}, nil
+}
+
+// 坐标和地址
+func (s *CustomerService) locate(ctx context.Context, location, city string) (string, string, error) {
+	location, place, err := s.cb.Locate(ctx, location, city)
+	if err != nil {
+		return "", "", err
+	}
+	if place != nil {
+		return location, place.Address, nil
+	}
+	return location, s.cb.Address(ctx, location), nil
+}
+
+func (s *CustomerService) CreateOrder(ctx context.Context, req *pb.CreateOrderReq) (*pb.CreateOrderResp, error) {
+	// 一，获取顾客id
+	customerID, err := ctxCustomerID(ctx)
+	if err != nil {
+		return &pb.CreateOrderResp{
+			Code:    1,
+			Message: "顾客信息获取错误",
+		}, nil
+	}
+	// 二，预估价格并创建订单
+	info, err := s.cb.CreateOrder(ctx, customerID, req.Origin, req.Destination, req.City, req.QuoteToken)
+	if err != nil {
+		return &pb.CreateOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.CreateOrderResp{
+		Code:    0,
+		Message: "create success",
+		Order:   toOrderInfo(info),
+	}, nil
+}
+
+func (s *CustomerService) CancelOrder(ctx context.Context, req *pb.CancelOrderReq) (*pb.CancelOrderResp, error) {
+	customerID, err := ctxCustomerID(ctx)
+	if err != nil {
+		return &pb.CancelOrderResp{
+			Code:    1,
+			Message: "顾客信息获取错误",
+		}, nil
+	}
+	info, err := s.cb.CancelOrder(ctx, customerID, uint(req.OrderId), req.Reason)
+	if err != nil {
+		return &pb.CancelOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.CancelOrderResp{
+		Code:    0,
+		Message: "cancel success",
+		OrderId: info.Id,
+		Status:  info.Status,
+	}, nil
+}
+
+func (s *CustomerService) GetOrder(ctx context.Context, req *pb.GetOrderReq) (*pb.GetOrderResp, error) {
+	customerID, err := ctxCustomerID(ctx)
+	if err != nil {
+		return &pb.GetOrderResp{
+			Code:    1,
+			Message: "顾客信息获取错误",
+		}, nil
+	}
+	info, err := s.cb.GetOrder(ctx, customerID, uint(req.OrderId))
+	if err != nil {
+		return &pb.GetOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.GetOrderResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Order:   toOrderInfo(info),
 	}, nil
 }
+
+func (s *CustomerService) ListOrders(ctx context.Context, req *pb.ListOrdersReq) (*pb.ListOrdersResp, error) {
+	customerID, err := ctxCustomerID(ctx)
+	if err != nil {
+		return &pb.ListOrdersResp{
+			Code:    1,
+			Message: "顾客信息获取错误",
+		}, nil
+	}
+	reply, err := s.cb.ListOrders(ctx, customerID, req.Status, req.StartDate, req.EndDate, req.Page, req.PageSize)
+	if err != nil {
+		return &pb.ListOrdersResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	orders := make([]*pb.OrderInfo, 0, len(reply.Orders))
+	for _, info := range reply.Orders {
+		orders = append(orders, toOrderInfo(info))
+	}
+	return &pb.ListOrdersResp{
+		Code:     0,
+		Message:  "SUCCESS",
+		Orders:   orders,
+		Total:    reply.Total,
+		Page:     reply.Page,
+		PageSize: reply.PageSize,
+	}, nil
+}
+
+func (s *CustomerService) PayOrder(ctx context.Context, req *pb.PayOrderReq) (*pb.PayOrderResp, error) {
+	// 一，获取顾客id
+	customerID, err := ctxCustomerID(ctx)
+	if err != nil {
+		return &pb.PayOrderResp{
+			Code:    1,
+			Message: "顾客信息获取错误",
+		}, nil
+	}
+	// 二，通过订单服务完成支付
+	info, err := s.cb.PayOrder(ctx, customerID, uint(req.OrderId), req.Amount)
+	if err != nil {
+		return &pb.PayOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.PayOrderResp{
+		Code:    0,
+		Message: "pay success",
+		OrderId: info.Id,
+		Status:  info.Status,
+	}, nil
+}
+
+// 获取 jwt 中记录的顾客id
+func ctxCustomerID(ctx context.Context) (uint, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return 0, errors.Unauthorized("UNAUTHORIZED", "claims not found")
+	}
+	jti, _ := claims.(jwtv5.MapClaims)["jti"].(string)
+	id, err := strconv.ParseUint(jti, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint(id), nil
+}
+
+// 订单服务的订单信息转换为顾客端的订单信息
+func toOrderInfo(info *order.OrderInfo) *pb.OrderInfo {
+	return &pb.OrderInfo{
+		Id:           info.Id,
+		DriverId:     info.DriverId,
+		Origin:       info.Origin,
+		Destination:  info.Destination,
+		Price:        info.Price,
+		FinalPrice:   info.FinalPrice,
+		Status:       info.Status,
+		CancelReason: info.CancelReason,
+		CreatedAt:    info.CreatedAt,
+		AcceptedAt:   info.AcceptedAt,
+		ArrivedAt:    info.ArrivedAt,
+		StartedAt:    info.StartedAt,
+		FinishedAt:   info.FinishedAt,
+		PaidAt:       info.PaidAt,
+		CanceledAt:   info.CanceledAt,
+		City:         info.City,
+	}
+}
+
+// 费用预估服务的费用明细转换为顾客端的费用明细
+func toFareBreakdown(fare *valuation.FareBreakdown) *pb.FareBreakdown {
+	if fare == nil {
+		return nil
+	}
+	return &pb.FareBreakdown{
+		Currency:         fare.Currency,
+		MinorUnit:        fare.MinorUnit,
+		Distance:         fare.Distance,
+		Duration:         fare.Duration,
+		StartFee:         fare.StartFee,
+		IncludedDistance: fare.IncludedDistance,
+		BillableDistance: fare.BillableDistance,
+		DistanceFee:      fare.DistanceFee,
+		DurationFee:      fare.DurationFee,
+		Surcharges:       toFareItems(fare.Surcharges),
+		Discounts:        toFareItems(fare.Discounts),
+		Total:            fare.Total,
+	}
+}
+
+func toFareItems(items []*valuation.FareItem) []*pb.FareItem {
+	result := make([]*pb.FareItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &pb.FareItem{
+			Code:   item.Code,
+			Name:   item.Name,
+			Amount: item.Amount,
+		})
+	}
+	return result
+}
+
+func toPlace(p *mapService.Place) *pb.Place {
+	return &pb.Place{
+		Name:     p.GetName(),
+		Address:  p.GetAddress(),
+		Location: p.GetLocation(),
+		Province: p.GetProvince(),
+		City:     p.GetCity(),
+		District: p.GetDistrict(),
+		Adcode:   p.GetAdcode(),
+	}
+}
+
+func toPlaces(places []*mapService.Place) []*pb.Place {
+	result := make([]*pb.Place, 0, len(places))
+	for _, p := range places {
+		result = append(result, toPlace(p))
+	}
+	return result
+}
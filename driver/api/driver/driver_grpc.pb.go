@@ -19,11 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Driver_IDNoCheck_FullMethodName     = "/api.driver.Driver/IDNoCheck"
-	Driver_GetVerifyCode_FullMethodName = "/api.driver.Driver/GetVerifyCode"
-	Driver_SubmitPhone_FullMethodName   = "/api.driver.Driver/SubmitPhone"
-	Driver_Login_FullMethodName         = "/api.driver.Driver/Login"
-	Driver_Logout_FullMethodName        = "/api.driver.Driver/Logout"
+	Driver_IDNoCheck_FullMethodName            = "/api.driver.Driver/IDNoCheck"
+	Driver_GetVerifyCode_FullMethodName        = "/api.driver.Driver/GetVerifyCode"
+	Driver_SubmitPhone_FullMethodName          = "/api.driver.Driver/SubmitPhone"
+	Driver_Login_FullMethodName                = "/api.driver.Driver/Login"
+	Driver_Logout_FullMethodName               = "/api.driver.Driver/Logout"
+	Driver_GoOnline_FullMethodName             = "/api.driver.Driver/GoOnline"
+	Driver_StartListen_FullMethodName          = "/api.driver.Driver/StartListen"
+	Driver_PauseListen_FullMethodName          = "/api.driver.Driver/PauseListen"
+	Driver_GoOffline_FullMethodName            = "/api.driver.Driver/GoOffline"
+	Driver_GetOffer_FullMethodName             = "/api.driver.Driver/GetOffer"
+	Driver_AcceptOrder_FullMethodName          = "/api.driver.Driver/AcceptOrder"
+	Driver_ArriveOrder_FullMethodName          = "/api.driver.Driver/ArriveOrder"
+	Driver_StartOrder_FullMethodName           = "/api.driver.Driver/StartOrder"
+	Driver_FinishOrder_FullMethodName          = "/api.driver.Driver/FinishOrder"
+	Driver_ReportLocation_FullMethodName       = "/api.driver.Driver/ReportLocation"
+	Driver_ReportLocationStream_FullMethodName = "/api.driver.Driver/ReportLocationStream"
+	Driver_NearbyDrivers_FullMethodName        = "/api.driver.Driver/NearbyDrivers"
+	Driver_ListAudits_FullMethodName           = "/api.driver.Driver/ListAudits"
+	Driver_ApproveAudit_FullMethodName         = "/api.driver.Driver/ApproveAudit"
+	Driver_RejectAudit_FullMethodName          = "/api.driver.Driver/RejectAudit"
 )
 
 // DriverClient is the client API for Driver service.
@@ -40,6 +55,36 @@ type DriverClient interface {
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	// 退出
 	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
+	// 上线（out => in）
+	GoOnline(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error)
+	// 开始听单（in => listen）
+	StartListen(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error)
+	// 暂停听单（listen => in）
+	PauseListen(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error)
+	// 下线（in, listen => out）
+	GoOffline(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error)
+	// 获取派给自己的订单，司机端轮询
+	GetOffer(ctx context.Context, in *GetOfferReq, opts ...grpc.CallOption) (*GetOfferResp, error)
+	// 接单
+	AcceptOrder(ctx context.Context, in *AcceptOrderReq, opts ...grpc.CallOption) (*AcceptOrderResp, error)
+	// 到达起点
+	ArriveOrder(ctx context.Context, in *ArriveOrderReq, opts ...grpc.CallOption) (*ArriveOrderResp, error)
+	// 开始代驾
+	StartOrder(ctx context.Context, in *StartOrderReq, opts ...grpc.CallOption) (*StartOrderResp, error)
+	// 结束代驾
+	FinishOrder(ctx context.Context, in *FinishOrderReq, opts ...grpc.CallOption) (*FinishOrderResp, error)
+	// 上报位置
+	ReportLocation(ctx context.Context, in *ReportLocationReq, opts ...grpc.CallOption) (*ReportLocationResp, error)
+	// 持续上报位置（grpc 客户端流），token 通过 metadata 的 authorization 传递
+	ReportLocationStream(ctx context.Context, opts ...grpc.CallOption) (Driver_ReportLocationStreamClient, error)
+	// 附近的听单司机，仅供服务间调用
+	NearbyDrivers(ctx context.Context, in *NearbyDriversReq, opts ...grpc.CallOption) (*NearbyDriversResp, error)
+	// 审核队列，以下审核接口仅供运营后台通过 grpc 调用，需要运营后台签发的 token
+	ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error)
+	// 审核通过，司机状态 stop => out
+	ApproveAudit(ctx context.Context, in *ApproveAuditReq, opts ...grpc.CallOption) (*AuditResp, error)
+	// 审核驳回
+	RejectAudit(ctx context.Context, in *RejectAuditReq, opts ...grpc.CallOption) (*AuditResp, error)
 }
 
 type driverClient struct {
@@ -100,6 +145,181 @@ func (c *driverClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.C
 	return out, nil
 }
 
+func (c *driverClient) GoOnline(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStatusResp)
+	err := c.cc.Invoke(ctx, Driver_GoOnline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) StartListen(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStatusResp)
+	err := c.cc.Invoke(ctx, Driver_StartListen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) PauseListen(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStatusResp)
+	err := c.cc.Invoke(ctx, Driver_PauseListen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) GoOffline(ctx context.Context, in *SetStatusReq, opts ...grpc.CallOption) (*SetStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStatusResp)
+	err := c.cc.Invoke(ctx, Driver_GoOffline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) GetOffer(ctx context.Context, in *GetOfferReq, opts ...grpc.CallOption) (*GetOfferResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOfferResp)
+	err := c.cc.Invoke(ctx, Driver_GetOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) AcceptOrder(ctx context.Context, in *AcceptOrderReq, opts ...grpc.CallOption) (*AcceptOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOrderResp)
+	err := c.cc.Invoke(ctx, Driver_AcceptOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ArriveOrder(ctx context.Context, in *ArriveOrderReq, opts ...grpc.CallOption) (*ArriveOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArriveOrderResp)
+	err := c.cc.Invoke(ctx, Driver_ArriveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) StartOrder(ctx context.Context, in *StartOrderReq, opts ...grpc.CallOption) (*StartOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOrderResp)
+	err := c.cc.Invoke(ctx, Driver_StartOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) FinishOrder(ctx context.Context, in *FinishOrderReq, opts ...grpc.CallOption) (*FinishOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishOrderResp)
+	err := c.cc.Invoke(ctx, Driver_FinishOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ReportLocation(ctx context.Context, in *ReportLocationReq, opts ...grpc.CallOption) (*ReportLocationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportLocationResp)
+	err := c.cc.Invoke(ctx, Driver_ReportLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ReportLocationStream(ctx context.Context, opts ...grpc.CallOption) (Driver_ReportLocationStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Driver_ServiceDesc.Streams[0], Driver_ReportLocationStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &driverReportLocationStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type Driver_ReportLocationStreamClient interface {
+	Send(*ReportLocationReq) error
+	CloseAndRecv() (*ReportLocationResp, error)
+	grpc.ClientStream
+}
+
+type driverReportLocationStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *driverReportLocationStreamClient) Send(m *ReportLocationReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *driverReportLocationStreamClient) CloseAndRecv() (*ReportLocationResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportLocationResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *driverClient) NearbyDrivers(ctx context.Context, in *NearbyDriversReq, opts ...grpc.CallOption) (*NearbyDriversResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearbyDriversResp)
+	err := c.cc.Invoke(ctx, Driver_NearbyDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditsResp)
+	err := c.cc.Invoke(ctx, Driver_ListAudits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) ApproveAudit(ctx context.Context, in *ApproveAuditReq, opts ...grpc.CallOption) (*AuditResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResp)
+	err := c.cc.Invoke(ctx, Driver_ApproveAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverClient) RejectAudit(ctx context.Context, in *RejectAuditReq, opts ...grpc.CallOption) (*AuditResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditResp)
+	err := c.cc.Invoke(ctx, Driver_RejectAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServer is the server API for Driver service.
 // All implementations must embed UnimplementedDriverServer
 // for forward compatibility
@@ -114,6 +334,36 @@ type DriverServer interface {
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	// 退出
 	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
+	// 上线（out => in）
+	GoOnline(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// 开始听单（in => listen）
+	StartListen(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// 暂停听单（listen => in）
+	PauseListen(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// 下线（in, listen => out）
+	GoOffline(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// 获取派给自己的订单，司机端轮询
+	GetOffer(context.Context, *GetOfferReq) (*GetOfferResp, error)
+	// 接单
+	AcceptOrder(context.Context, *AcceptOrderReq) (*AcceptOrderResp, error)
+	// 到达起点
+	ArriveOrder(context.Context, *ArriveOrderReq) (*ArriveOrderResp, error)
+	// 开始代驾
+	StartOrder(context.Context, *StartOrderReq) (*StartOrderResp, error)
+	// 结束代驾
+	FinishOrder(context.Context, *FinishOrderReq) (*FinishOrderResp, error)
+	// 上报位置
+	ReportLocation(context.Context, *ReportLocationReq) (*ReportLocationResp, error)
+	// 持续上报位置（grpc 客户端流），token 通过 metadata 的 authorization 传递
+	ReportLocationStream(Driver_ReportLocationStreamServer) error
+	// 附近的听单司机，仅供服务间调用
+	NearbyDrivers(context.Context, *NearbyDriversReq) (*NearbyDriversResp, error)
+	// 审核队列，以下审核接口仅供运营后台通过 grpc 调用，需要运营后台签发的 token
+	ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error)
+	// 审核通过，司机状态 stop => out
+	ApproveAudit(context.Context, *ApproveAuditReq) (*AuditResp, error)
+	// 审核驳回
+	RejectAudit(context.Context, *RejectAuditReq) (*AuditResp, error)
 	mustEmbedUnimplementedDriverServer()
 }
 
@@ -136,6 +386,51 @@ func (UnimplementedDriverServer) Login(context.Context, *LoginReq) (*LoginResp,
 func (UnimplementedDriverServer) Logout(context.Context, *LogoutReq) (*LogoutResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedDriverServer) GoOnline(context.Context, *SetStatusReq) (*SetStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOnline not implemented")
+}
+func (UnimplementedDriverServer) StartListen(context.Context, *SetStatusReq) (*SetStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartListen not implemented")
+}
+func (UnimplementedDriverServer) PauseListen(context.Context, *SetStatusReq) (*SetStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseListen not implemented")
+}
+func (UnimplementedDriverServer) GoOffline(context.Context, *SetStatusReq) (*SetStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOffline not implemented")
+}
+func (UnimplementedDriverServer) GetOffer(context.Context, *GetOfferReq) (*GetOfferResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffer not implemented")
+}
+func (UnimplementedDriverServer) AcceptOrder(context.Context, *AcceptOrderReq) (*AcceptOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrder not implemented")
+}
+func (UnimplementedDriverServer) ArriveOrder(context.Context, *ArriveOrderReq) (*ArriveOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArriveOrder not implemented")
+}
+func (UnimplementedDriverServer) StartOrder(context.Context, *StartOrderReq) (*StartOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOrder not implemented")
+}
+func (UnimplementedDriverServer) FinishOrder(context.Context, *FinishOrderReq) (*FinishOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishOrder not implemented")
+}
+func (UnimplementedDriverServer) ReportLocation(context.Context, *ReportLocationReq) (*ReportLocationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportLocation not implemented")
+}
+func (UnimplementedDriverServer) ReportLocationStream(Driver_ReportLocationStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportLocationStream not implemented")
+}
+func (UnimplementedDriverServer) NearbyDrivers(context.Context, *NearbyDriversReq) (*NearbyDriversResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearbyDrivers not implemented")
+}
+func (UnimplementedDriverServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
+}
+func (UnimplementedDriverServer) ApproveAudit(context.Context, *ApproveAuditReq) (*AuditResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAudit not implemented")
+}
+func (UnimplementedDriverServer) RejectAudit(context.Context, *RejectAuditReq) (*AuditResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAudit not implemented")
+}
 func (UnimplementedDriverServer) mustEmbedUnimplementedDriverServer() {}
 
 // UnsafeDriverServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +534,284 @@ func _Driver_Logout_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Driver_GoOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).GoOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_GoOnline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).GoOnline(ctx, req.(*SetStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_StartListen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).StartListen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_StartListen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).StartListen(ctx, req.(*SetStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_PauseListen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).PauseListen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_PauseListen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).PauseListen(ctx, req.(*SetStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_GoOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).GoOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_GoOffline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).GoOffline(ctx, req.(*SetStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_GetOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).GetOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_GetOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).GetOffer(ctx, req.(*GetOfferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_AcceptOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).AcceptOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_AcceptOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).AcceptOrder(ctx, req.(*AcceptOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ArriveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArriveOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ArriveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_ArriveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ArriveOrder(ctx, req.(*ArriveOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_StartOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).StartOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_StartOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).StartOrder(ctx, req.(*StartOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_FinishOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).FinishOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_FinishOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).FinishOrder(ctx, req.(*FinishOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ReportLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLocationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ReportLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_ReportLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ReportLocation(ctx, req.(*ReportLocationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ReportLocationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DriverServer).ReportLocationStream(&driverReportLocationStreamServer{ServerStream: stream})
+}
+
+type Driver_ReportLocationStreamServer interface {
+	SendAndClose(*ReportLocationResp) error
+	Recv() (*ReportLocationReq, error)
+	grpc.ServerStream
+}
+
+type driverReportLocationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *driverReportLocationStreamServer) SendAndClose(m *ReportLocationResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *driverReportLocationStreamServer) Recv() (*ReportLocationReq, error) {
+	m := new(ReportLocationReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Driver_NearbyDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearbyDriversReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).NearbyDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_NearbyDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).NearbyDrivers(ctx, req.(*NearbyDriversReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ListAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_ListAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ListAudits(ctx, req.(*ListAuditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_ApproveAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAuditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).ApproveAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_ApproveAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).ApproveAudit(ctx, req.(*ApproveAuditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Driver_RejectAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAuditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServer).RejectAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Driver_RejectAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServer).RejectAudit(ctx, req.(*RejectAuditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Driver_ServiceDesc is the grpc.ServiceDesc for Driver service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,7 +839,69 @@ var Driver_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _Driver_Logout_Handler,
 		},
+		{
+			MethodName: "GoOnline",
+			Handler:    _Driver_GoOnline_Handler,
+		},
+		{
+			MethodName: "StartListen",
+			Handler:    _Driver_StartListen_Handler,
+		},
+		{
+			MethodName: "PauseListen",
+			Handler:    _Driver_PauseListen_Handler,
+		},
+		{
+			MethodName: "GoOffline",
+			Handler:    _Driver_GoOffline_Handler,
+		},
+		{
+			MethodName: "GetOffer",
+			Handler:    _Driver_GetOffer_Handler,
+		},
+		{
+			MethodName: "AcceptOrder",
+			Handler:    _Driver_AcceptOrder_Handler,
+		},
+		{
+			MethodName: "ArriveOrder",
+			Handler:    _Driver_ArriveOrder_Handler,
+		},
+		{
+			MethodName: "StartOrder",
+			Handler:    _Driver_StartOrder_Handler,
+		},
+		{
+			MethodName: "FinishOrder",
+			Handler:    _Driver_FinishOrder_Handler,
+		},
+		{
+			MethodName: "ReportLocation",
+			Handler:    _Driver_ReportLocation_Handler,
+		},
+		{
+			MethodName: "NearbyDrivers",
+			Handler:    _Driver_NearbyDrivers_Handler,
+		},
+		{
+			MethodName: "ListAudits",
+			Handler:    _Driver_ListAudits_Handler,
+		},
+		{
+			MethodName: "ApproveAudit",
+			Handler:    _Driver_ApproveAudit_Handler,
+		},
+		{
+			MethodName: "RejectAudit",
+			Handler:    _Driver_RejectAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportLocationStream",
+			Handler:       _Driver_ReportLocationStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/driver/driver.proto",
 }
@@ -19,21 +19,51 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationDriverAcceptOrder = "/api.driver.Driver/AcceptOrder"
+const OperationDriverArriveOrder = "/api.driver.Driver/ArriveOrder"
+const OperationDriverFinishOrder = "/api.driver.Driver/FinishOrder"
+const OperationDriverGetOffer = "/api.driver.Driver/GetOffer"
 const OperationDriverGetVerifyCode = "/api.driver.Driver/GetVerifyCode"
+const OperationDriverGoOffline = "/api.driver.Driver/GoOffline"
+const OperationDriverGoOnline = "/api.driver.Driver/GoOnline"
 const OperationDriverIDNoCheck = "/api.driver.Driver/IDNoCheck"
 const OperationDriverLogin = "/api.driver.Driver/Login"
 const OperationDriverLogout = "/api.driver.Driver/Logout"
+const OperationDriverPauseListen = "/api.driver.Driver/PauseListen"
+const OperationDriverReportLocation = "/api.driver.Driver/ReportLocation"
+const OperationDriverStartListen = "/api.driver.Driver/StartListen"
+const OperationDriverStartOrder = "/api.driver.Driver/StartOrder"
 const OperationDriverSubmitPhone = "/api.driver.Driver/SubmitPhone"
 
 type DriverHTTPServer interface {
+	// AcceptOrder 接单
+	AcceptOrder(context.Context, *AcceptOrderReq) (*AcceptOrderResp, error)
+	// ArriveOrder 到达起点
+	ArriveOrder(context.Context, *ArriveOrderReq) (*ArriveOrderResp, error)
+	// FinishOrder 结束代驾
+	FinishOrder(context.Context, *FinishOrderReq) (*FinishOrderResp, error)
+	// GetOffer 获取派给自己的订单，司机端轮询
+	GetOffer(context.Context, *GetOfferReq) (*GetOfferResp, error)
 	// GetVerifyCode 获取验证码
 	GetVerifyCode(context.Context, *GetVerifyCodeReq) (*GetVerifyCodeResp, error)
+	// GoOffline 下线（in, listen => out）
+	GoOffline(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// GoOnline 上线（out => in）
+	GoOnline(context.Context, *SetStatusReq) (*SetStatusResp, error)
 	// IDNoCheck 校验身份证号码
 	IDNoCheck(context.Context, *IDNoCheckReq) (*IDNoCheckResp, error)
 	// Login 登录
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	// Logout 退出
 	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
+	// PauseListen 暂停听单（listen => in）
+	PauseListen(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// ReportLocation 上报位置
+	ReportLocation(context.Context, *ReportLocationReq) (*ReportLocationResp, error)
+	// StartListen 开始听单（in => listen）
+	StartListen(context.Context, *SetStatusReq) (*SetStatusResp, error)
+	// StartOrder 开始代驾
+	StartOrder(context.Context, *StartOrderReq) (*StartOrderResp, error)
 	// SubmitPhone 提交电话号码
 	SubmitPhone(context.Context, *SubmitPhoneReq) (*SubmitPhoneResp, error)
 }
@@ -45,6 +75,16 @@ func RegisterDriverHTTPServer(s *http.Server, srv DriverHTTPServer) {
 	r.POST("/driver/submit-phone", _Driver_SubmitPhone0_HTTP_Handler(srv))
 	r.POST("/driver/login", _Driver_Login0_HTTP_Handler(srv))
 	r.DELETE("/driver/logout", _Driver_Logout0_HTTP_Handler(srv))
+	r.POST("/driver/status/online", _Driver_GoOnline0_HTTP_Handler(srv))
+	r.POST("/driver/status/listen", _Driver_StartListen0_HTTP_Handler(srv))
+	r.POST("/driver/status/pause", _Driver_PauseListen0_HTTP_Handler(srv))
+	r.POST("/driver/status/offline", _Driver_GoOffline0_HTTP_Handler(srv))
+	r.GET("/driver/offer", _Driver_GetOffer0_HTTP_Handler(srv))
+	r.POST("/driver/order/{order_id}/accept", _Driver_AcceptOrder0_HTTP_Handler(srv))
+	r.POST("/driver/order/{order_id}/arrive", _Driver_ArriveOrder0_HTTP_Handler(srv))
+	r.POST("/driver/order/{order_id}/start", _Driver_StartOrder0_HTTP_Handler(srv))
+	r.POST("/driver/order/{order_id}/finish", _Driver_FinishOrder0_HTTP_Handler(srv))
+	r.POST("/driver/location", _Driver_ReportLocation0_HTTP_Handler(srv))
 }
 
 func _Driver_IDNoCheck0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
@@ -154,11 +194,250 @@ func _Driver_Logout0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _Driver_GoOnline0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStatusReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverGoOnline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoOnline(ctx, req.(*SetStatusReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStatusResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_StartListen0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStatusReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverStartListen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartListen(ctx, req.(*SetStatusReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStatusResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_PauseListen0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStatusReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverPauseListen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseListen(ctx, req.(*SetStatusReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStatusResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_GoOffline0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStatusReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverGoOffline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoOffline(ctx, req.(*SetStatusReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStatusResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_GetOffer0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetOfferReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverGetOffer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetOffer(ctx, req.(*GetOfferReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetOfferResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_AcceptOrder0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverAcceptOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptOrder(ctx, req.(*AcceptOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_ArriveOrder0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ArriveOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverArriveOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ArriveOrder(ctx, req.(*ArriveOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ArriveOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_StartOrder0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverStartOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartOrder(ctx, req.(*StartOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StartOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_FinishOrder0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FinishOrderReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverFinishOrder)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishOrder(ctx, req.(*FinishOrderReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FinishOrderResp)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Driver_ReportLocation0_HTTP_Handler(srv DriverHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportLocationReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDriverReportLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportLocation(ctx, req.(*ReportLocationReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportLocationResp)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DriverHTTPClient interface {
+	AcceptOrder(ctx context.Context, req *AcceptOrderReq, opts ...http.CallOption) (rsp *AcceptOrderResp, err error)
+	ArriveOrder(ctx context.Context, req *ArriveOrderReq, opts ...http.CallOption) (rsp *ArriveOrderResp, err error)
+	FinishOrder(ctx context.Context, req *FinishOrderReq, opts ...http.CallOption) (rsp *FinishOrderResp, err error)
+	GetOffer(ctx context.Context, req *GetOfferReq, opts ...http.CallOption) (rsp *GetOfferResp, err error)
 	GetVerifyCode(ctx context.Context, req *GetVerifyCodeReq, opts ...http.CallOption) (rsp *GetVerifyCodeResp, err error)
+	GoOffline(ctx context.Context, req *SetStatusReq, opts ...http.CallOption) (rsp *SetStatusResp, err error)
+	GoOnline(ctx context.Context, req *SetStatusReq, opts ...http.CallOption) (rsp *SetStatusResp, err error)
 	IDNoCheck(ctx context.Context, req *IDNoCheckReq, opts ...http.CallOption) (rsp *IDNoCheckResp, err error)
 	Login(ctx context.Context, req *LoginReq, opts ...http.CallOption) (rsp *LoginResp, err error)
 	Logout(ctx context.Context, req *LogoutReq, opts ...http.CallOption) (rsp *LogoutResp, err error)
+	PauseListen(ctx context.Context, req *SetStatusReq, opts ...http.CallOption) (rsp *SetStatusResp, err error)
+	ReportLocation(ctx context.Context, req *ReportLocationReq, opts ...http.CallOption) (rsp *ReportLocationResp, err error)
+	StartListen(ctx context.Context, req *SetStatusReq, opts ...http.CallOption) (rsp *SetStatusResp, err error)
+	StartOrder(ctx context.Context, req *StartOrderReq, opts ...http.CallOption) (rsp *StartOrderResp, err error)
 	SubmitPhone(ctx context.Context, req *SubmitPhoneReq, opts ...http.CallOption) (rsp *SubmitPhoneResp, err error)
 }
 
@@ -170,6 +449,58 @@ func NewDriverHTTPClient(client *http.Client) DriverHTTPClient {
 	return &DriverHTTPClientImpl{client}
 }
 
+func (c *DriverHTTPClientImpl) AcceptOrder(ctx context.Context, in *AcceptOrderReq, opts ...http.CallOption) (*AcceptOrderResp, error) {
+	var out AcceptOrderResp
+	pattern := "/driver/order/{order_id}/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverAcceptOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) ArriveOrder(ctx context.Context, in *ArriveOrderReq, opts ...http.CallOption) (*ArriveOrderResp, error) {
+	var out ArriveOrderResp
+	pattern := "/driver/order/{order_id}/arrive"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverArriveOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) FinishOrder(ctx context.Context, in *FinishOrderReq, opts ...http.CallOption) (*FinishOrderResp, error) {
+	var out FinishOrderResp
+	pattern := "/driver/order/{order_id}/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverFinishOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) GetOffer(ctx context.Context, in *GetOfferReq, opts ...http.CallOption) (*GetOfferResp, error) {
+	var out GetOfferResp
+	pattern := "/driver/offer"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDriverGetOffer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DriverHTTPClientImpl) GetVerifyCode(ctx context.Context, in *GetVerifyCodeReq, opts ...http.CallOption) (*GetVerifyCodeResp, error) {
 	var out GetVerifyCodeResp
 	pattern := "/driver/get-verify-code/{telephone}"
@@ -183,6 +514,32 @@ func (c *DriverHTTPClientImpl) GetVerifyCode(ctx context.Context, in *GetVerifyC
 	return &out, nil
 }
 
+func (c *DriverHTTPClientImpl) GoOffline(ctx context.Context, in *SetStatusReq, opts ...http.CallOption) (*SetStatusResp, error) {
+	var out SetStatusResp
+	pattern := "/driver/status/offline"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverGoOffline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) GoOnline(ctx context.Context, in *SetStatusReq, opts ...http.CallOption) (*SetStatusResp, error) {
+	var out SetStatusResp
+	pattern := "/driver/status/online"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverGoOnline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DriverHTTPClientImpl) IDNoCheck(ctx context.Context, in *IDNoCheckReq, opts ...http.CallOption) (*IDNoCheckResp, error) {
 	var out IDNoCheckResp
 	pattern := "/driver/idno-check"
@@ -222,6 +579,58 @@ func (c *DriverHTTPClientImpl) Logout(ctx context.Context, in *LogoutReq, opts .
 	return &out, nil
 }
 
+func (c *DriverHTTPClientImpl) PauseListen(ctx context.Context, in *SetStatusReq, opts ...http.CallOption) (*SetStatusResp, error) {
+	var out SetStatusResp
+	pattern := "/driver/status/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverPauseListen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) ReportLocation(ctx context.Context, in *ReportLocationReq, opts ...http.CallOption) (*ReportLocationResp, error) {
+	var out ReportLocationResp
+	pattern := "/driver/location"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverReportLocation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) StartListen(ctx context.Context, in *SetStatusReq, opts ...http.CallOption) (*SetStatusResp, error) {
+	var out SetStatusResp
+	pattern := "/driver/status/listen"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverStartListen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *DriverHTTPClientImpl) StartOrder(ctx context.Context, in *StartOrderReq, opts ...http.CallOption) (*StartOrderResp, error) {
+	var out StartOrderResp
+	pattern := "/driver/order/{order_id}/start"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDriverStartOrder))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *DriverHTTPClientImpl) SubmitPhone(ctx context.Context, in *SubmitPhoneReq, opts ...http.CallOption) (*SubmitPhoneResp, error) {
 	var out SubmitPhoneResp
 	pattern := "/driver/submit-phone"
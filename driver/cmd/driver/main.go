@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
 	"github.com/google/uuid"
 	"github.com/hashicorp/consul/api"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -80,6 +82,8 @@ func main() {
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
+			// 环境变量，去掉 LAOMADJ_ 前缀后用于替换配置文件中的 ${NAME:default}
+			env.NewSource("LAOMADJ_"),
 		),
 	)
 	defer c.Close()
@@ -92,8 +96,12 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 密钥没有默认值，未配置或过短时拒绝启动
+	if err := checkSecrets(&bc); err != nil {
+		panic(err)
+	}
 
-	app, cleanup, err := wireApp(bc.Service, bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Service, bc.Server, bc.Data, bc.Auth, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -145,3 +153,17 @@ func initTracer(url string) error {
 	otel.SetTracerProvider(tracerProvider)
 	return nil
 }
+
+// HS256 密钥的最小长度
+const secretLenMin = 32
+
+// 检查配置中的密钥
+func checkSecrets(bc *conf.Bootstrap) error {
+	if len(bc.GetAuth().GetSecret()) < secretLenMin {
+		return fmt.Errorf("auth.secret must be at least %d characters, set LAOMADJ_DRIVER_AUTH_SECRET", secretLenMin)
+	}
+	if len(bc.GetAuth().GetServiceSecret()) < secretLenMin {
+		return fmt.Errorf("auth.service_secret must be at least %d characters, set LAOMADJ_SERVICE_SECRET", secretLenMin)
+	}
+	return nil
+}
@@ -23,21 +23,69 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	dataData, cleanup, err := data.NewData(confData, confService, logger)
+func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	driverInterface := data.NewDriverInterface(dataData)
-	driverBiz := biz.NewDriverBiz(driverInterface)
-	driverService := service.NewDriverService(driverBiz)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, driverService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, driverService, logger)
+	clientFactory, cleanup2, err := data.NewClientFactory(confService, auth, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	verifyCodeClient, err := data.NewVerifyCodeClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	driverInterface := data.NewDriverInterface(dataData, verifyCodeClient)
+	driverBiz := biz.NewDriverBiz(driverInterface, auth)
+	orderClient, err := data.NewOrderClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	orderInterface := data.NewOrderInterface(dataData, orderClient)
+	orderBiz := biz.NewOrderBiz(orderInterface)
+	valuationClient, err := data.NewValuationClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	supplyReporter, cleanup3 := data.NewSupplyReporter(valuationClient, logger)
+	locationInterface := data.NewLocationInterface(dataData, supplyReporter)
+	locationBiz := biz.NewLocationBiz(locationInterface)
+	auditInterface := data.NewAuditInterface(dataData)
+	auditBiz := biz.NewAuditBiz(auditInterface)
+	documentInterface := data.NewDocumentInterface(dataData)
+	blobStore, err := data.NewBlobStore(confData)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	documentBiz := biz.NewDocumentBiz(documentInterface, blobStore)
+	driverService := service.NewDriverService(driverBiz, orderBiz, locationBiz, auditBiz, documentBiz)
+	trustedProxies, err := server.NewTrustedProxies(confServer)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, auth, greeterService, driverService, verifyCodeClient, trustedProxies, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, driverService, verifyCodeClient, trustedProxies, logger)
 	app := newApp(confService, logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,231 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 审核状态常量
+const AuditStatusPending = "pending"
+const AuditStatusApproved = "approved"
+const AuditStatusRejected = "rejected"
+
+// 司机的年龄限制，按身份证中的出生日期计算
+const DriverAgeMin = 18
+const DriverAgeMax = 60
+
+// 审核队列分页参数的默认值与上限
+const AuditPageSizeDefault = 20
+const AuditPageSizeMax = 100
+
+var (
+	ErrIDNoFormat    = errors.BadRequest("IDNO_FORMAT_ERROR", "身份证号码格式错误")
+	ErrIDNoChecksum  = errors.BadRequest("IDNO_CHECKSUM_ERROR", "身份证号码校验位错误")
+	ErrIDNoBirth     = errors.BadRequest("IDNO_BIRTH_ERROR", "身份证号码中的出生日期错误")
+	ErrIDNoAge       = errors.BadRequest("IDNO_AGE_ERROR", "年龄不符合司机要求")
+	ErrIDNoUsed      = errors.Conflict("IDNO_USED", "身份证号码已被其他司机使用")
+	ErrIDNoAudited   = errors.BadRequest("IDNO_AUDITED", "已通过审核，不能修改身份信息")
+	ErrDriverName    = errors.BadRequest("DRIVER_NAME_ERROR", "姓名格式错误")
+	ErrAuditParam    = errors.BadRequest("AUDIT_PARAM_ERROR", "audit param error")
+	ErrAuditStatus   = errors.BadRequest("AUDIT_STATUS_ERROR", "audit is not pending")
+	ErrAuditNotFound = errors.NotFound("AUDIT_NOT_FOUND", "audit not found")
+)
+
+// 身份证号码：17 位数字 + 1 位校验码
+var idNoPattern = regexp.MustCompile(`^\d{17}[\dX]$`)
+
+// 姓名：汉字、字母、间隔号
+var driverNamePattern = regexp.MustCompile(`^[\p{Han}A-Za-z·• ]{2,32}$`)
+
+// GB 11643 校验码的加权因子和校验码
+var idNoWeights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+var idNoCheckCodes = "10X98765432"
+
+// 身份证号码前两位的省级行政区划代码
+var idNoProvinces = map[string]struct{}{
+	"11": {}, "12": {}, "13": {}, "14": {}, "15": {},
+	"21": {}, "22": {}, "23": {},
+	"31": {}, "32": {}, "33": {}, "34": {}, "35": {}, "36": {}, "37": {},
+	"41": {}, "42": {}, "43": {}, "44": {}, "45": {}, "46": {},
+	"50": {}, "51": {}, "52": {}, "53": {}, "54": {},
+	"61": {}, "62": {}, "63": {}, "64": {}, "65": {},
+	"71": {}, "81": {}, "82": {}, "83": {}, "91": {},
+}
+
+// 司机审核表模型，提交身份信息后进入审核队列
+type DriverAudit struct {
+	gorm.Model
+	DriverID  uint           `gorm:"index;" json:"driver_id"`
+	Name      string         `gorm:"type:varchar(255);" json:"name"`
+	IdNumber  string         `gorm:"type:char(18);" json:"id_number"`
+	Status    string         `gorm:"type:enum('pending','approved','rejected');index;" json:"status"`
+	Reason    sql.NullString `gorm:"type:varchar(255);" json:"reason"`
+	Operator  sql.NullString `gorm:"type:varchar(64);" json:"operator"`
+	AuditedAt sql.NullTime   `gorm:"" json:"audited_at"`
+	// 审核时参考的司机信息（证件照片等），不入库
+	Driver *Driver `gorm:"-" json:"driver"`
+}
+
+// 审核相关的资源操作接口
+type AuditInterface interface {
+	// 保存司机的身份信息，并加入审核队列（已有待审核记录时更新该记录）
+	SubmitIDNo(ctx context.Context, driverID uint, name, idNumber string) (*DriverAudit, error)
+	GetAudit(ctx context.Context, id uint) (*DriverAudit, error)
+	// 分页查询审核记录，同时返回总数
+	ListAudits(ctx context.Context, status string, page, pageSize int) ([]*DriverAudit, int64, error)
+	// 审核，仅当审核记录仍为 pending 时更新
+	// 通过时 log 不为空，需要同时迁移司机状态、设置 AuditAt 并记录状态变更
+	ReviewAudit(ctx context.Context, audit *DriverAudit, log *DriverStatusLog) error
+}
+
+// 审核业务逻辑
+type AuditBiz struct {
+	AI AuditInterface
+}
+
+// AuditBiz 构造器
+func NewAuditBiz(ai AuditInterface) *AuditBiz {
+	return &AuditBiz{
+		AI: ai,
+	}
+}
+
+// 校验身份证号码，返回规范化（校验码大写）后的号码
+// 一，格式与行政区划；二，GB 11643 校验码；三，出生日期与年龄
+func CheckIDNo(idNumber string, now time.Time) (string, error) {
+	idNumber = strings.ToUpper(strings.TrimSpace(idNumber))
+	if !idNoPattern.MatchString(idNumber) {
+		return "", ErrIDNoFormat
+	}
+	if _, ok := idNoProvinces[idNumber[:2]]; !ok {
+		return "", ErrIDNoFormat
+	}
+	sum := 0
+	for i, w := range idNoWeights {
+		sum += int(idNumber[i]-'0') * w
+	}
+	if idNoCheckCodes[sum%11] != idNumber[17] {
+		return "", ErrIDNoChecksum
+	}
+	birth, err := time.ParseInLocation("20060102", idNumber[6:14], now.Location())
+	if err != nil || birth.After(now) {
+		return "", ErrIDNoBirth
+	}
+	age := now.Year() - birth.Year()
+	// 还没过今年的生日
+	if now.Month() < birth.Month() || now.Month() == birth.Month() && now.Day() < birth.Day() {
+		age--
+	}
+	if age < DriverAgeMin || age > DriverAgeMax {
+		return "", ErrIDNoAge.WithMetadata(map[string]string{
+			"age": strconv.Itoa(age),
+		})
+	}
+	return idNumber, nil
+}
+
+// 提交身份信息，校验通过后保存到登录司机并进入审核队列
+func (ab *AuditBiz) SubmitIDNo(ctx context.Context, driver *Driver, name, idNumber string) (*DriverAudit, error) {
+	if driver.ID == 0 {
+		return nil, errors.Unauthorized("Unauthorized", "driver not found")
+	}
+	if driver.AuditAt.Valid || driver.Status.String != DriverStatusStop {
+		return nil, ErrIDNoAudited
+	}
+	name = strings.TrimSpace(name)
+	if !driverNamePattern.MatchString(name) {
+		return nil, ErrDriverName
+	}
+	idNumber, err := CheckIDNo(idNumber, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return ab.AI.SubmitIDNo(ctx, driver.ID, name, idNumber)
+}
+
+// 审核队列
+func (ab *AuditBiz) ListAudits(ctx context.Context, status string, page, pageSize int) ([]*DriverAudit, int64, error) {
+	if status == "" {
+		status = AuditStatusPending
+	}
+	if status != AuditStatusPending && status != AuditStatusApproved && status != AuditStatusRejected {
+		return nil, 0, ErrAuditParam
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = AuditPageSizeDefault
+	}
+	if pageSize > AuditPageSizeMax {
+		pageSize = AuditPageSizeMax
+	}
+	return ab.AI.ListAudits(ctx, status, page, pageSize)
+}
+
+// 审核通过，司机状态 stop => out
+func (ab *AuditBiz) ApproveAudit(ctx context.Context, id uint, operator string) (*DriverAudit, error) {
+	audit, err := ab.pendingAudit(ctx, id, operator)
+	if err != nil {
+		return nil, err
+	}
+	to, err := NextDriverStatus(DriverStatusStop, DriverEventApprove)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	audit.Status = AuditStatusApproved
+	audit.AuditedAt = sql.NullTime{Time: now, Valid: true}
+	if err := ab.AI.ReviewAudit(ctx, audit, &DriverStatusLog{
+		DriverID:  audit.DriverID,
+		From:      DriverStatusStop,
+		To:        to,
+		Event:     DriverEventApprove,
+		ChangedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+	return audit, nil
+}
+
+// 审核驳回，司机保持 stop，可以重新提交
+func (ab *AuditBiz) RejectAudit(ctx context.Context, id uint, operator, reason string) (*DriverAudit, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrAuditParam
+	}
+	audit, err := ab.pendingAudit(ctx, id, operator)
+	if err != nil {
+		return nil, err
+	}
+	audit.Status = AuditStatusRejected
+	audit.Reason = sql.NullString{String: reason, Valid: true}
+	audit.AuditedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := ab.AI.ReviewAudit(ctx, audit, nil); err != nil {
+		return nil, err
+	}
+	return audit, nil
+}
+
+// 获取待审核的记录，并记录审核人
+func (ab *AuditBiz) pendingAudit(ctx context.Context, id uint, operator string) (*DriverAudit, error) {
+	operator = strings.TrimSpace(operator)
+	if id == 0 || operator == "" {
+		return nil, ErrAuditParam
+	}
+	audit, err := ab.AI.GetAudit(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if audit.Status != AuditStatusPending {
+		return nil, ErrAuditStatus
+	}
+	audit.Operator = sql.NullString{String: operator, Valid: true}
+	return audit, nil
+}
@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCheckIDNo(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 12, 0, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name     string
+		idNumber string
+		now      time.Time
+		want     string
+		err      *errors.Error
+	}{
+		{"合法", "110105199003071239", day(2026, 1, 1), "110105199003071239", nil},
+		{"去除空白", " 110105199003071239 ", day(2026, 1, 1), "110105199003071239", nil},
+		{"闰年生日", "440306198802291237", day(2026, 1, 1), "440306198802291237", nil},
+		{"小写 x 转为大写", "11010519491231002x", day(1990, 1, 1), "11010519491231002X", nil},
+		{"长度错误", "11010519900307123", day(2026, 1, 1), "", ErrIDNoFormat},
+		{"包含字母", "1101051990030712A9", day(2026, 1, 1), "", ErrIDNoFormat},
+		{"省级代码错误", "990105199003071232", day(2026, 1, 1), "", ErrIDNoFormat},
+		{"校验位错误", "110105199003071230", day(2026, 1, 1), "", ErrIDNoChecksum},
+		{"月份错误", "110105199013071232", day(2026, 1, 1), "", ErrIDNoBirth},
+		{"出生日期在未来", "110105200801011236", day(2007, 12, 31), "", ErrIDNoBirth},
+		{"刚满 18 岁", "110105200801011236", day(2026, 1, 1), "110105200801011236", nil},
+		{"差一天 18 岁", "110105200801011236", day(2025, 12, 31), "", ErrIDNoAge},
+		{"60 岁", "110105196512311233", day(2026, 12, 30), "110105196512311233", nil},
+		{"超过 60 岁", "110105196512301238", day(2026, 12, 30), "", ErrIDNoAge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CheckIDNo(tt.idNumber, tt.now)
+			if tt.err == nil {
+				if err != nil || got != tt.want {
+					t.Fatalf("CheckIDNo(%q) = %q, %v, want %q", tt.idNumber, got, err, tt.want)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("CheckIDNo(%q) error = %v, want %v", tt.idNumber, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"time"
+)
+
+// 证件照片的大小上限，单位 byte
+const DocumentSizeMax = 5 << 20
+
+// 证件照片的像素数上限，解码前根据图片头部的宽高检查，避免解压炸弹
+const DocumentPixelsMax = 25000000
+
+// 重新编码 jpeg 时的质量
+const documentJPEGQuality = 90
+
+// 证件类型 => 司机表中保存 object key 的字段
+var documentFields = map[string]string{
+	"id_image_a":      "id_image_a",      // 身份证人像面
+	"license_image_a": "license_image_a", // 驾驶证正页
+	"license_image_b": "license_image_b", // 驾驶证副页
+}
+
+// 允许上传的图片类型 => 扩展名
+var documentTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+var (
+	ErrDocumentKind   = errors.BadRequest("DOCUMENT_KIND_ERROR", "不支持的证件类型")
+	ErrDocumentSize   = errors.BadRequest("DOCUMENT_SIZE_ERROR", "文件为空或超过 5MB")
+	ErrDocumentType   = errors.BadRequest("DOCUMENT_TYPE_ERROR", "只支持 jpeg、png 格式的图片")
+	ErrDocumentPixels = errors.BadRequest("DOCUMENT_PIXELS_ERROR", "图片尺寸超过限制")
+)
+
+// 文件存储接口，可以是本地文件系统或 S3 兼容的对象存储
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+}
+
+// 证件相关的资源操作接口
+type DocumentInterface interface {
+	// 保存证件的 object key 到司机表的 field 字段
+	SaveDocument(ctx context.Context, driverID uint, field, key string) error
+}
+
+// 证件上传业务逻辑
+type DocumentBiz struct {
+	DI DocumentInterface
+	BS BlobStore
+}
+
+// DocumentBiz 构造器
+func NewDocumentBiz(di DocumentInterface, bs BlobStore) *DocumentBiz {
+	return &DocumentBiz{
+		DI: di,
+		BS: bs,
+	}
+}
+
+// 上传证件照片，返回 object key
+// 一，校验类型、大小和尺寸；二，按 EXIF 方向旋转后重新编码图片，去除 EXIF 等元数据；三，存储并保存 key
+func (db *DocumentBiz) Upload(ctx context.Context, driver *Driver, kind string, data []byte) (string, error) {
+	if driver.ID == 0 {
+		return "", errors.Unauthorized("Unauthorized", "driver not found")
+	}
+	// 审核通过后证件不能再修改
+	if driver.AuditAt.Valid {
+		return "", ErrIDNoAudited
+	}
+	field, ok := documentFields[kind]
+	if !ok {
+		return "", ErrDocumentKind
+	}
+	if len(data) == 0 || len(data) > DocumentSizeMax {
+		return "", ErrDocumentSize
+	}
+	// 根据文件内容判断类型，不信任客户端声明的类型
+	contentType := http.DetectContentType(data)
+	ext, ok := documentTypes[contentType]
+	if !ok {
+		return "", ErrDocumentType
+	}
+	clean, err := stripMetadata(data, contentType)
+	if err != nil {
+		if errors.Is(err, ErrDocumentPixels) {
+			return "", err
+		}
+		return "", ErrDocumentType
+	}
+	key, err := documentKey(driver.ID, kind, ext)
+	if err != nil {
+		return "", err
+	}
+	if err := db.BS.Put(ctx, key, clean, contentType); err != nil {
+		return "", err
+	}
+	if err := db.DI.SaveDocument(ctx, driver.ID, field, key); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// 解码后重新编码，只保留像素数据，EXIF（位置、设备等信息）随之去除
+// 去除 EXIF 前先按其中的方向旋转，保证去除后图片的显示方向不变
+func stripMetadata(data []byte, contentType string) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > DocumentPixelsMax {
+		return nil, ErrDocumentPixels
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	buf := &bytes.Buffer{}
+	switch contentType {
+	case "image/png":
+		err = png.Encode(buf, img)
+	default:
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: documentJPEGQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// 生成 object key：drivers/{driver_id}/{kind}/{日期}-{随机串}.{ext}
+func documentKey(driverID uint, kind, ext string) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("drivers/%d/%s/%s-%s.%s", driverID, kind, time.Now().Format("20060102"), hex.EncodeToString(b), ext), nil
+}
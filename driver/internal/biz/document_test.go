@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 在 jpeg 的 SOI 之后插入只包含方向的 EXIF
+func withOrientation(t *testing.T, data []byte, orientation uint16, order binary.ByteOrder) []byte {
+	t.Helper()
+	tiff := &bytes.Buffer{}
+	if order == binary.LittleEndian {
+		tiff.WriteString("II")
+	} else {
+		tiff.WriteString("MM")
+	}
+	_ = binary.Write(tiff, order, uint16(42))
+	_ = binary.Write(tiff, order, uint32(8))
+	_ = binary.Write(tiff, order, uint16(1))
+	_ = binary.Write(tiff, order, uint16(exifOrientationTag))
+	_ = binary.Write(tiff, order, uint16(3)) // SHORT
+	_ = binary.Write(tiff, order, uint32(1))
+	_ = binary.Write(tiff, order, orientation)
+	_ = binary.Write(tiff, order, uint16(0))
+	_ = binary.Write(tiff, order, uint32(0))
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	out := &bytes.Buffer{}
+	out.Write(data[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(out, binary.BigEndian, uint16(len(payload)+2))
+	out.Write(payload)
+	out.Write(data[2:])
+	return out.Bytes()
+}
+
+// 左半部分为白色、右半部分为黑色的 jpeg
+func testJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	data := testJPEG(t, 16, 8)
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"没有 EXIF", data, 1},
+		{"小端", withOrientation(t, data, 6, binary.LittleEndian), 6},
+		{"大端", withOrientation(t, data, 8, binary.BigEndian), 8},
+		{"非法的方向", withOrientation(t, data, 9, binary.BigEndian), 1},
+		{"不是 jpeg", []byte("not a jpeg"), 1},
+		{"截断", withOrientation(t, data, 6, binary.LittleEndian)[:12], 1},
+	}
+	for _, tt := range tests {
+		if got := jpegOrientation(tt.data); got != tt.want {
+			t.Errorf("%s: jpegOrientation = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 2x3 的图片，每个像素的灰度不同
+	src := image.NewGray(image.Rect(0, 0, 2, 3))
+	for i := range src.Pix {
+		src.Pix[i] = uint8(i + 1)
+	}
+	// 变换后按行排列的像素
+	tests := []struct {
+		orientation int
+		w, h        int
+		want        []uint8
+	}{
+		{1, 2, 3, []uint8{1, 2, 3, 4, 5, 6}},
+		{2, 2, 3, []uint8{2, 1, 4, 3, 6, 5}},
+		{3, 2, 3, []uint8{6, 5, 4, 3, 2, 1}},
+		{4, 2, 3, []uint8{5, 6, 3, 4, 1, 2}},
+		{5, 3, 2, []uint8{1, 3, 5, 2, 4, 6}},
+		{6, 3, 2, []uint8{5, 3, 1, 6, 4, 2}},
+		{7, 3, 2, []uint8{6, 4, 2, 5, 3, 1}},
+		{8, 3, 2, []uint8{2, 4, 6, 1, 3, 5}},
+	}
+	for _, tt := range tests {
+		img := applyOrientation(src, tt.orientation)
+		b := img.Bounds()
+		if b.Dx() != tt.w || b.Dy() != tt.h {
+			t.Errorf("orientation %d: size %dx%d, want %dx%d", tt.orientation, b.Dx(), b.Dy(), tt.w, tt.h)
+			continue
+		}
+		got := make([]uint8, 0, len(tt.want))
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				got = append(got, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+			}
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("orientation %d: pixels %v, want %v", tt.orientation, got, tt.want)
+		}
+	}
+}
+
+func TestStripMetadataOrientation(t *testing.T) {
+	// 16x8，左白右黑，方向 6 表示需要顺时针旋转 90°，旋转后为 8x16，上白下黑
+	data := withOrientation(t, testJPEG(t, 16, 8), 6, binary.BigEndian)
+	clean, err := stripMetadata(data, "image/jpeg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jpegOrientation(clean) != 1 || bytes.Contains(clean, []byte("Exif")) {
+		t.Fatal("EXIF was not stripped")
+	}
+	img, err := jpeg.Decode(bytes.NewReader(clean))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 8 || b.Dy() != 16 {
+		t.Fatalf("size %dx%d, want 8x16", b.Dx(), b.Dy())
+	}
+	top := color.GrayModel.Convert(img.At(4, 2)).(color.Gray).Y
+	bottom := color.GrayModel.Convert(img.At(4, 13)).(color.Gray).Y
+	if top < 200 || bottom > 50 {
+		t.Fatalf("top %d, bottom %d, want white on top", top, bottom)
+	}
+}
+
+func TestStripMetadataPixels(t *testing.T) {
+	// 1x1 的 png，将 IHDR 中的宽高改为 10000x10000，解码像素前即被拒绝
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, image.NewGray(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	// 8 字节签名 + 4 字节长度 + 4 字节 IHDR，之后是宽、高
+	binary.BigEndian.PutUint32(data[16:], 10000)
+	binary.BigEndian.PutUint32(data[20:], 10000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	if _, err := stripMetadata(data, "image/png"); !errors.Is(err, ErrDocumentPixels) {
+		t.Fatalf("stripMetadata error = %v, want ErrDocumentPixels", err)
+	}
+}
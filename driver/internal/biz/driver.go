@@ -3,19 +3,20 @@ package biz
 import (
 	"context"
 	"database/sql"
+	"driver/internal/conf"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"regexp"
-	"strings"
 	"time"
 )
 
-const SecretKey = "driver secret key"
-
 // 司机业务逻辑
 type DriverBiz struct {
 	DI DriverInterface
+	// token 的签发配置
+	auth *conf.Auth
 }
 
 // 司机表模型
@@ -47,41 +48,71 @@ const DriverStatusOut = "out"
 const DriverStatusIn = "in"
 const DriverStatusListen = "listen"
 const DriverStatusStop = "stop"
-const DriverTokenLife = 1 * 30 * 24 * 3600
+
+// 手机号格式
+var telephonePattern = regexp.MustCompile(`^(13\d|14[01456879]|15[0-35-9]|16[2567]|17[0-8]|18\d|19[0-35-9])\d{8}$`)
+
+// 校验手机号格式，获取验证码的限流中间件同样使用，格式错误的号码不计数
+func ValidTelephone(tel string) bool {
+	return telephonePattern.MatchString(tel)
+}
 
 // 司机相关的资源操作接口
 type DriverInterface interface {
-	GetVerifyCode(context.Context, string) (string, error)
-	FetchVerifyCode(context.Context, string) (string, error)
+	// 签发验证码，返回验证码和有效期（second）
+	IssueVerifyCode(ctx context.Context, tel string) (string, int64, error)
+	// 校验验证码，只能成功一次
+	VerifyCode(ctx context.Context, tel, code string) error
+	// 获取司机信息，只包含 id、电话、状态、区号和审核时间，不包含 token 和证件信息
 	FetchInfoByTel(context.Context, string) (*Driver, error)
 	InitDriverInfo(context.Context, string) (*Driver, error)
-	GetSavedVerifyCode(context.Context, string) (string, error)
 	SaveToken(context.Context, string, string) error
 	GetToken(context.Context, string) (string, error)
+	// 修改状态，仅当司机仍处于 log.From 状态时才更新，同时写入变更记录
+	ChangeStatus(ctx context.Context, log *DriverStatusLog) error
+	// 清除司机表中的token
+	ClearToken(ctx context.Context, tel string) error
+	// 将 JWT ID 加入撤销列表，ttl 为 token 的剩余有效期
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) error
+	// JWT ID 是否已被撤销
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 }
 
 // DriverBiz 构造器
-func NewDriverBiz(di DriverInterface) *DriverBiz {
+func NewDriverBiz(di DriverInterface, auth *conf.Auth) *DriverBiz {
 	return &DriverBiz{
-		DI: di,
+		DI:   di,
+		auth: auth,
 	}
 }
 
+// token 的有效期
+func (db *DriverBiz) TokenLife() time.Duration {
+	return db.auth.TokenLife.AsDuration()
+}
+
+// 签名和校验 token 的密钥
+func (db *DriverBiz) Secret() []byte {
+	return []byte(db.auth.Secret)
+}
+
 // 验证登录信息方法
 func (db *DriverBiz) CheckLogin(ctx context.Context, tel, verifyCode string) (string, error) {
-	// 验证验证码是否正确
-	code, err := db.DI.GetSavedVerifyCode(ctx, tel)
-	if err != nil {
+	// 验证验证码是否正确，由验证码服务校验，成功后验证码失效
+	if err := db.DI.VerifyCode(ctx, tel, verifyCode); err != nil {
 		return "", err
 	}
-	if verifyCode != code {
-		return "", errors.New(1, "verify code error", "'")
-	}
 	// 生成token
-	token, err := generateJWT(tel)
+	token, err := db.generateJWT(tel)
 	if err != nil {
 		return "", err
 	}
+	// 撤销之前登录的token，同一个司机只能在一个设备上登录
+	if old, err := db.DI.GetToken(ctx, tel); err == nil && old != "" {
+		if err := db.RevokeToken(ctx, old); err != nil {
+			return "", err
+		}
+	}
 	// 存储到driver表中
 	if err := db.DI.SaveToken(ctx, tel, token); err != nil {
 		return "", err
@@ -91,27 +122,95 @@ func (db *DriverBiz) CheckLogin(ctx context.Context, tel, verifyCode string) (st
 }
 
 // 生成JWT token
-func generateJWT(tel string) (string, error) {
+// sub 为司机的电话，jti 为每个token唯一的id，用于撤销
+func (db *DriverBiz) generateJWT(tel string) (string, error) {
 	// 构建token类型
 	claims := jwt.RegisteredClaims{
-		Issuer:    "LaomaDJ",
-		Subject:   "driver authentication",
+		Issuer:    db.auth.Issuer,
+		Subject:   tel,
 		Audience:  []string{"driver"},
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(DriverTokenLife * time.Second)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(db.TokenLife())),
 		NotBefore: nil,
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ID:        tel,
+		ID:        uuid.NewString(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	// 签名
 	// 生成token字符串
-	tokenString, err := token.SignedString([]byte(SecretKey))
+	tokenString, err := token.SignedString(db.Secret())
 	if err != nil {
 		return "", err
 	}
 	return tokenString, nil
 }
 
+// 校验 token 并获取对应的司机，用于无法使用 JWT 中间件的场景（例如 grpc 流）
+func (db *DriverBiz) CheckToken(ctx context.Context, tokenString string) (*Driver, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return db.Secret(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return nil, errors.Unauthorized("Unauthorized", "token invalid")
+	}
+	return db.CheckClaims(ctx, token.Claims.(jwt.MapClaims))
+}
+
+// 校验已通过签名验证的 claims：token 未被撤销，并获取对应的司机
+// 撤销列表和司机信息都存储在 redis 中，缓存未命中时才查询司机表
+func (db *DriverBiz) CheckClaims(ctx context.Context, claims jwt.MapClaims) (*Driver, error) {
+	jti, _ := claims["jti"].(string)
+	tel, _ := claims["sub"].(string)
+	if jti == "" || tel == "" {
+		return nil, errors.Unauthorized("Unauthorized", "token invalid")
+	}
+	revoked, err := db.DI.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return nil, errors.Unauthorized("Unauthorized", "token check failed")
+	}
+	if revoked {
+		return nil, errors.Unauthorized("Unauthorized", "token was revoked")
+	}
+	driver, err := db.DI.FetchInfoByTel(ctx, tel)
+	if err != nil {
+		return nil, errors.Unauthorized("Unauthorized", "driver not found")
+	}
+	return driver, nil
+}
+
+// 退出登录，撤销当前token并清除司机表中的token
+func (db *DriverBiz) Logout(ctx context.Context, driver *Driver, claims jwt.MapClaims) error {
+	if err := db.revokeClaims(ctx, claims); err != nil {
+		return err
+	}
+	return db.DI.ClearToken(ctx, driver.Telephone)
+}
+
+// 撤销token，已过期或无法解析的token不需要撤销
+func (db *DriverBiz) RevokeToken(ctx context.Context, tokenString string) error {
+	claims := jwt.MapClaims{}
+	// 只用于读取 jti 和 exp，不校验有效期
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return db.Secret(), nil
+	}, jwt.WithoutClaimsValidation()); err != nil {
+		return nil
+	}
+	return db.revokeClaims(ctx, claims)
+}
+
+// 将 jti 加入撤销列表，保留到 token 过期为止
+func (db *DriverBiz) revokeClaims(ctx context.Context, claims jwt.MapClaims) error {
+	jti, _ := claims["jti"].(string)
+	exp, err := claims.GetExpirationTime()
+	if jti == "" || err != nil || exp == nil {
+		return nil
+	}
+	ttl := time.Until(exp.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	return db.DI.RevokeToken(ctx, jti, ttl)
+}
+
 // 比对验证码是否一致
 // 将司机信息入库
 func (db *DriverBiz) InitDriverInfo(ctx context.Context, tel string) (*Driver, error) {
@@ -126,40 +225,13 @@ func (db *DriverBiz) InitDriverInfo(ctx context.Context, tel string) (*Driver, e
 }
 
 // 实现获取验证码的业务逻辑
-func (db *DriverBiz) GetVerifyCode(ctx context.Context, tel string) (string, error) {
+func (db *DriverBiz) GetVerifyCode(ctx context.Context, tel string) (string, int64, error) {
 	// 一，校验手机号
-	pattern := `^(13\d|14[01456879]|15[0-35-9]|16[2567]|17[0-8]|18\d|19[0-35-9])\d{8}$`
-	// 返回一个编译后的正则表达式对象
-	regexpPattern := regexp.MustCompile(pattern)
-	if !regexpPattern.MatchString(tel) {
-		return "", errors.New(200, "DRIVER", "driver telephone error")
+	if !ValidTelephone(tel) {
+		return "", 0, errors.New(200, "DRIVER", "driver telephone error")
 	}
 	// 二，调用data/获取验证码
-	return db.DI.GetVerifyCode(ctx, tel)
-}
-
-func (db *DriverBiz) CheckVerifyCode(ctx context.Context, tel, code string) bool {
-	// 一，校验手机号
-	pattern := `^(13\d|14[01456879]|15[0-35-9]|16[2567]|17[0-8]|18\d|19[0-35-9])\d{8}$`
-	regexpPattern := regexp.MustCompile(pattern)
-	if !regexpPattern.MatchString(tel) {
-		return false
-	}
-
-	code = strings.TrimSpace(code)
-	if len(code) == 0 {
-		return false
-	}
-
-	verifyCode, err := db.DI.FetchVerifyCode(ctx, tel)
-	if err != nil {
-		return false
-	}
-	if verifyCode == code {
-		return true
-	}
-
-	return false
+	return db.DI.IssueVerifyCode(ctx, tel)
 }
 
 func (db *DriverBiz) GetInfoByTel(ctx context.Context, tel string) (*Driver, error) {
@@ -0,0 +1,24 @@
+package biz
+
+import "testing"
+
+func TestValidTelephone(t *testing.T) {
+	tests := []struct {
+		tel  string
+		want bool
+	}{
+		{"13800000000", true},
+		{"19912345678", true},
+		{"12345678901", false},
+		{"1380000000", false},
+		{"138000000000", false},
+		{"1380000000a", false},
+		{" 13800000000", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := ValidTelephone(tt.tel); got != tt.want {
+			t.Errorf("ValidTelephone(%q) = %t, want %t", tt.tel, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 位置上报后的有效期，单位 second，超过该时间未上报视为离线
+const DriverLocationTTL = 60
+
+// 附近司机查询的限制
+const NearbyRadiusMax = 50000 // 单位 m
+const NearbyLimitDefault = 10
+const NearbyLimitMax = 100
+
+var (
+	ErrLocationParam = errors.BadRequest("LOCATION_PARAM_ERROR", "location param error")
+	ErrLocationCity  = errors.BadRequest("LOCATION_CITY_ERROR", "city is required")
+)
+
+// 城市编码，作为 redis key 的一部分
+var cityPattern = regexp.MustCompile(`^\w{1,16}$`)
+
+// 司机位置
+type DriverLocation struct {
+	DriverID  uint
+	City      string
+	Lng       float64
+	Lat       float64
+	Distance  float64 // 与搜索中心的距离，单位 m，仅查询时有值
+	LocatedAt time.Time
+}
+
+// 司机位置相关的资源操作接口
+type LocationInterface interface {
+	// 保存司机的最新位置
+	SaveLocation(context.Context, *DriverLocation) error
+	// 查询半径内在 since 之后上报过位置的司机，按距离由近到远排列
+	SearchLocations(ctx context.Context, city string, lng, lat, radius float64, since time.Time) ([]*DriverLocation, error)
+	// 从 ids 中筛选出状态为 listen 的司机
+	ListeningDrivers(ctx context.Context, ids []uint) (map[uint]struct{}, error)
+	// 上报听单司机的位置，计入所在区域的供给（Valuation 服务）
+	ReportSupply(context.Context, *DriverLocation) error
+}
+
+// 司机位置业务逻辑
+type LocationBiz struct {
+	LI LocationInterface
+}
+
+// LocationBiz 构造器
+func NewLocationBiz(li LocationInterface) *LocationBiz {
+	return &LocationBiz{
+		LI: li,
+	}
+}
+
+// 上报位置，city 为空时使用司机的区号
+func (lb *LocationBiz) ReportLocation(ctx context.Context, driver *Driver, city string, lng, lat float64) error {
+	if driver.ID == 0 {
+		return errors.Unauthorized("Unauthorized", "driver not found")
+	}
+	city = strings.TrimSpace(city)
+	if city == "" {
+		city = driver.DistinctCode.String
+	}
+	if !cityPattern.MatchString(city) {
+		return ErrLocationCity
+	}
+	if !validCoordinate(lng, lat) {
+		return ErrLocationParam
+	}
+	location := &DriverLocation{
+		DriverID:  driver.ID,
+		City:      city,
+		Lng:       lng,
+		Lat:       lat,
+		LocatedAt: time.Now(),
+	}
+	if err := lb.LI.SaveLocation(ctx, location); err != nil {
+		return err
+	}
+	// 听单中的司机计入所在区域的供给，用于动态调价，上报失败不影响位置上报
+	if driver.Status.String == DriverStatusListen {
+		_ = lb.LI.ReportSupply(ctx, location)
+	}
+	return nil
+}
+
+// 附近的听单司机
+// 位置超过 DriverLocationTTL 未更新的司机和非 listen 状态的司机都会被过滤
+func (lb *LocationBiz) NearbyDrivers(ctx context.Context, city string, lng, lat, radius float64, limit int) ([]*DriverLocation, error) {
+	if !cityPattern.MatchString(city) {
+		return nil, ErrLocationCity
+	}
+	if !validCoordinate(lng, lat) || radius <= 0 || radius > NearbyRadiusMax {
+		return nil, ErrLocationParam
+	}
+	if limit <= 0 {
+		limit = NearbyLimitDefault
+	}
+	if limit > NearbyLimitMax {
+		limit = NearbyLimitMax
+	}
+	since := time.Now().Add(-DriverLocationTTL * time.Second)
+	locations, err := lb.LI.SearchLocations(ctx, city, lng, lat, radius, since)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return locations, nil
+	}
+	ids := make([]uint, 0, len(locations))
+	for _, l := range locations {
+		ids = append(ids, l.DriverID)
+	}
+	listening, err := lb.LI.ListeningDrivers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	nearby := make([]*DriverLocation, 0, limit)
+	for _, l := range locations {
+		if _, ok := listening[l.DriverID]; !ok {
+			continue
+		}
+		nearby = append(nearby, l)
+		if len(nearby) == limit {
+			break
+		}
+	}
+	return nearby, nil
+}
+
+// 校验经纬度，纬度范围受 redis GEO 的限制
+func validCoordinate(lng, lat float64) bool {
+	return lng >= -180 && lng <= 180 && lat >= -85.05112878 && lat <= 85.05112878
+}
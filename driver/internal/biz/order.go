@@ -0,0 +1,52 @@
+package biz
+
+import (
+	"context"
+	"driver/api/order"
+)
+
+// 司机端订单相关的资源操作接口（由 Order 服务完成）
+type OrderInterface interface {
+	AcceptOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error)
+	ArriveOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error)
+	StartOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error)
+	FinishOrder(ctx context.Context, orderID, driverID uint, odometer int64) (*order.OrderInfo, error)
+	GetOffer(ctx context.Context, driverID uint) (*order.OfferReply, error)
+}
+
+// 司机端订单业务逻辑
+type OrderBiz struct {
+	OI OrderInterface
+}
+
+// OrderBiz 构造器
+func NewOrderBiz(oi OrderInterface) *OrderBiz {
+	return &OrderBiz{
+		OI: oi,
+	}
+}
+
+// 接单
+func (ob *OrderBiz) AcceptOrder(ctx context.Context, driver *Driver, orderID uint) (*order.OrderInfo, error) {
+	return ob.OI.AcceptOrder(ctx, orderID, driver.ID)
+}
+
+// 到达起点
+func (ob *OrderBiz) ArriveOrder(ctx context.Context, driver *Driver, orderID uint) (*order.OrderInfo, error) {
+	return ob.OI.ArriveOrder(ctx, orderID, driver.ID)
+}
+
+// 开始代驾
+func (ob *OrderBiz) StartOrder(ctx context.Context, driver *Driver, orderID uint) (*order.OrderInfo, error) {
+	return ob.OI.StartOrder(ctx, orderID, driver.ID)
+}
+
+// 结束代驾，实际费用由 Order 服务结算
+func (ob *OrderBiz) FinishOrder(ctx context.Context, driver *Driver, orderID uint, odometer int64) (*order.OrderInfo, error) {
+	return ob.OI.FinishOrder(ctx, orderID, driver.ID, odometer)
+}
+
+// 获取派给自己的订单，没有时 Order 为空
+func (ob *OrderBiz) GetOffer(ctx context.Context, driver *Driver) (*order.OfferReply, error) {
+	return ob.OI.GetOffer(ctx, driver.ID)
+}
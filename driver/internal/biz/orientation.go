@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// EXIF 中方向的 tag
+const exifOrientationTag = 0x0112
+
+// 读取 jpeg 的 EXIF 方向（1-8），没有 EXIF 或无法解析时为 1（不需要旋转）
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// 图像数据开始，之后不会再有 EXIF
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+	return 1
+}
+
+// 在 TIFF 结构的第一个 IFD 中查找方向
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	n := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < n; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != exifOrientationTag {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+	return 1
+}
+
+// 按 EXIF 方向变换图片，使其按正常方向显示
+// 2 水平翻转，3 旋转 180°，4 垂直翻转，5 转置，6 顺时针旋转 90°，7 反转置，8 逆时针旋转 90°
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	// 目标坐标 => 原图坐标
+	var src func(x, y int) (int, int)
+	switch orientation {
+	case 2:
+		src = func(x, y int) (int, int) { return w - 1 - x, y }
+	case 3:
+		src = func(x, y int) (int, int) { return w - 1 - x, h - 1 - y }
+	case 4:
+		src = func(x, y int) (int, int) { return x, h - 1 - y }
+	case 5:
+		src = func(x, y int) (int, int) { return y, x }
+	case 6:
+		src = func(x, y int) (int, int) { return y, h - 1 - x }
+	case 7:
+		src = func(x, y int) (int, int) { return w - 1 - y, h - 1 - x }
+	case 8:
+		src = func(x, y int) (int, int) { return w - 1 - y, x }
+	}
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	// 先转换为 RGBA，逐像素复制时不需要每次做颜色模型转换
+	rgba := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			sx, sy := src(x, y)
+			si := rgba.PixOffset(sx, sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], rgba.Pix[si:si+4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 触发司机状态迁移的事件
+const DriverEventOnline = "online"   // 上线
+const DriverEventListen = "listen"   // 开始听单
+const DriverEventPause = "pause"     // 暂停听单
+const DriverEventOffline = "offline" // 下线
+const DriverEventApprove = "approve" // 审核通过
+
+// 司机状态机，当前状态 + 事件 => 目标状态
+// stop 为未审核或被封禁的司机，只能通过审核变为 out，因此不能听单
+var driverTransitions = map[string]map[string]string{
+	DriverStatusStop: {
+		DriverEventApprove: DriverStatusOut,
+	},
+	DriverStatusOut: {
+		DriverEventOnline: DriverStatusIn,
+	},
+	DriverStatusIn: {
+		DriverEventListen:  DriverStatusListen,
+		DriverEventOffline: DriverStatusOut,
+	},
+	DriverStatusListen: {
+		DriverEventPause:   DriverStatusIn,
+		DriverEventOffline: DriverStatusOut,
+	},
+}
+
+var (
+	ErrDriverStatus   = errors.BadRequest("DRIVER_STATUS_ERROR", "illegal driver status transition")
+	ErrDriverConflict = errors.Conflict("DRIVER_STATUS_CONFLICT", "driver status was changed by others")
+)
+
+// 司机状态变更记录表模型，用于统计在线时长
+type DriverStatusLog struct {
+	gorm.Model
+	DriverID  uint      `gorm:"index:idx_driver_changed;" json:"driver_id"`
+	From      string    `gorm:"type:varchar(16);" json:"from"`
+	To        string    `gorm:"type:varchar(16);" json:"to"`
+	Event     string    `gorm:"type:varchar(16);" json:"event"`
+	ChangedAt time.Time `gorm:"index:idx_driver_changed;" json:"changed_at"`
+}
+
+// 根据当前状态和事件，计算迁移后的状态
+func NextDriverStatus(status, event string) (string, error) {
+	next, ok := driverTransitions[status][event]
+	if !ok {
+		return "", ErrDriverStatus.WithMetadata(map[string]string{
+			"status": status,
+			"event":  event,
+		})
+	}
+	return next, nil
+}
+
+// 修改司机状态，并记录变更
+// 审核通过只能由审核流程触发
+func (db *DriverBiz) SetStatus(ctx context.Context, driver *Driver, event string) (string, error) {
+	if event == DriverEventApprove {
+		return "", ErrDriverStatus
+	}
+	if driver.ID == 0 {
+		return "", errors.Unauthorized("Unauthorized", "driver not found")
+	}
+	from := driver.Status.String
+	to, err := NextDriverStatus(from, event)
+	if err != nil {
+		return "", err
+	}
+	if err := db.DI.ChangeStatus(ctx, &DriverStatusLog{
+		DriverID:  driver.ID,
+		From:      from,
+		To:        to,
+		Event:     event,
+		ChangedAt: time.Now(),
+	}); err != nil {
+		return "", err
+	}
+	return to, nil
+}
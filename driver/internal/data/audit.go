@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"driver/internal/biz"
+	"errors"
+	"gorm.io/gorm"
+)
+
+type AuditData struct {
+	data *Data
+}
+
+func NewAuditInterface(data *Data) biz.AuditInterface {
+	return &AuditData{data: data}
+}
+
+// 保存身份信息并加入审核队列，在同一事务中完成
+func (ad *AuditData) SubmitIDNo(ctx context.Context, driverID uint, name, idNumber string) (*biz.DriverAudit, error) {
+	audit := &biz.DriverAudit{}
+	defer ad.data.dropDriverCacheByID(ctx, driverID)
+	err := ad.data.Mdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 一，身份证号码不能被其他司机使用
+		var count int64
+		if err := tx.Model(&biz.Driver{}).Where("id_number=? AND id<>?", idNumber, driverID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return biz.ErrIDNoUsed
+		}
+		// 二，保存到司机，只有未审核的司机可以修改
+		result := tx.Model(&biz.Driver{}).
+			Where("id=? AND status=? AND audit_at IS NULL", driverID, biz.DriverStatusStop).
+			Updates(map[string]interface{}{
+				"name":      sql.NullString{String: name, Valid: true},
+				"id_number": sql.NullString{String: idNumber, Valid: true},
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 信息未变化时也不会更新，需要区分
+			var n int64
+			if err := tx.Model(&biz.Driver{}).Where("id=? AND status=? AND audit_at IS NULL", driverID, biz.DriverStatusStop).Count(&n).Error; err != nil {
+				return err
+			}
+			if n == 0 {
+				return biz.ErrIDNoAudited
+			}
+		}
+		// 三，已有待审核记录时更新，否则新建
+		err := tx.Where("driver_id=? AND status=?", driverID, biz.AuditStatusPending).First(audit).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			audit.DriverID = driverID
+			audit.Status = biz.AuditStatusPending
+		} else if err != nil {
+			return err
+		}
+		audit.Name = name
+		audit.IdNumber = idNumber
+		return tx.Save(audit).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return audit, nil
+}
+
+// 获取审核记录
+func (ad *AuditData) GetAudit(ctx context.Context, id uint) (*biz.DriverAudit, error) {
+	audit := &biz.DriverAudit{}
+	if err := ad.data.Mdb.WithContext(ctx).First(audit, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrAuditNotFound
+		}
+		return nil, err
+	}
+	return audit, nil
+}
+
+// 分页查询审核记录，待审核的按提交时间正序（先提交先审核），其他倒序
+func (ad *AuditData) ListAudits(ctx context.Context, status string, page, pageSize int) ([]*biz.DriverAudit, int64, error) {
+	query := ad.data.Mdb.WithContext(ctx).Model(&biz.DriverAudit{}).Where("status=?", status)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	audits := []*biz.DriverAudit{}
+	if total == 0 {
+		return audits, 0, nil
+	}
+	order := "id DESC"
+	if status == biz.AuditStatusPending {
+		order = "id ASC"
+	}
+	if err := query.Order(order).Offset((page - 1) * pageSize).Limit(pageSize).Find(&audits).Error; err != nil {
+		return nil, 0, err
+	}
+	// 补充司机信息
+	ids := make([]uint, 0, len(audits))
+	for _, a := range audits {
+		ids = append(ids, a.DriverID)
+	}
+	drivers := []*biz.Driver{}
+	if err := ad.data.Mdb.WithContext(ctx).Where("id IN ?", ids).Find(&drivers).Error; err != nil {
+		return nil, 0, err
+	}
+	driverMap := make(map[uint]*biz.Driver, len(drivers))
+	for _, d := range drivers {
+		driverMap[d.ID] = d
+	}
+	for _, a := range audits {
+		a.Driver = driverMap[a.DriverID]
+	}
+	return audits, total, nil
+}
+
+// 审核，审核记录与司机状态在同一事务中更新
+func (ad *AuditData) ReviewAudit(ctx context.Context, audit *biz.DriverAudit, log *biz.DriverStatusLog) error {
+	defer ad.data.dropDriverCacheByID(ctx, audit.DriverID)
+	return ad.data.Mdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&biz.DriverAudit{}).
+			Where("id=? AND status=?", audit.ID, biz.AuditStatusPending).
+			Updates(map[string]interface{}{
+				"status":     audit.Status,
+				"reason":     audit.Reason,
+				"operator":   audit.Operator,
+				"audited_at": audit.AuditedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrAuditStatus
+		}
+		if log == nil {
+			return nil
+		}
+		result = tx.Model(&biz.Driver{}).
+			Where("id=? AND status=?", log.DriverID, log.From).
+			Updates(map[string]interface{}{
+				"status":   log.To,
+				"audit_at": audit.AuditedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrDriverConflict
+		}
+		return tx.Create(log).Error
+	})
+}
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"driver/internal/biz"
+	"driver/internal/conf"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 根据配置创建文件存储，默认使用本地文件系统
+func NewBlobStore(c *conf.Data) (biz.BlobStore, error) {
+	blob := c.GetBlob()
+	switch blob.GetDriver() {
+	case "s3":
+		s3 := blob.GetS3()
+		if s3.GetEndpoint() == "" || s3.GetBucket() == "" {
+			return nil, fmt.Errorf("blob s3 endpoint and bucket are required")
+		}
+		region := s3.GetRegion()
+		if region == "" {
+			region = "us-east-1"
+		}
+		return &S3BlobStore{
+			endpoint:  strings.TrimRight(s3.GetEndpoint(), "/"),
+			region:    region,
+			bucket:    s3.GetBucket(),
+			accessKey: s3.GetAccessKey(),
+			secretKey: s3.GetSecretKey(),
+			client:    &http.Client{Timeout: 30 * time.Second},
+		}, nil
+	case "", "local":
+		dir := blob.GetLocal().GetDir()
+		if dir == "" {
+			dir = "./uploads"
+		}
+		return &LocalBlobStore{dir: dir}, nil
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", blob.GetDriver())
+	}
+}
+
+// 本地文件系统存储，key 作为相对路径
+type LocalBlobStore struct {
+	dir string
+}
+
+func (ls *LocalBlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path := filepath.Join(ls.dir, filepath.FromSlash(key))
+	// key 不能跳出存储目录
+	if !strings.HasPrefix(path, filepath.Clean(ls.dir)+string(filepath.Separator)) {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// 先写临时文件再重命名，避免读到写了一半的文件
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// S3 兼容的对象存储（AWS S3、MinIO 等），使用 path-style 地址和 Signature V4 签名
+type S3BlobStore struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+func (ss *S3BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	u, err := url.Parse(ss.endpoint + "/" + ss.bucket + "/" + key)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", contentType)
+	ss.sign(req, data, time.Now().UTC())
+	resp, err := ss.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 put %s: %s %s", key, resp.Status, body)
+	}
+	return nil
+}
+
+// AWS Signature Version 4
+func (ss *S3BlobStore) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// 一，规范请求
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	// 二，待签名字符串
+	scope := date + "/" + ss.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	// 三，派生签名密钥并签名
+	kDate := hmacSHA256([]byte("AWS4"+ss.secretKey), date)
+	kRegion := hmacSHA256(kDate, ss.region)
+	kService := hmacSHA256(kRegion, "s3")
+	kSigning := hmacSHA256(kService, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(kSigning, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		ss.accessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"driver/internal/biz"
+	"encoding/json"
+	"time"
+)
+
+// 司机信息缓存的有效期，司机表更新后删除缓存，有效期用于兜底遗漏的删除和并发读写时写入的旧数据
+// 位置上报按缓存中的状态判断是否计入供给，状态最多延迟一个有效期
+const driverCacheTTL = 30 * time.Second
+
+// 缓存的司机信息，只包含 token 校验和状态判断需要的字段，不缓存 token、身份证号等敏感信息
+type driverCache struct {
+	ID           uint           `json:"id"`
+	Telephone    string         `json:"telephone"`
+	Status       sql.NullString `json:"status"`
+	DistinctCode sql.NullString `json:"distinct_code"`
+	AuditAt      sql.NullTime   `json:"audit_at"`
+}
+
+func toDriverCache(driver *biz.Driver) *driverCache {
+	return &driverCache{
+		ID:           driver.ID,
+		Telephone:    driver.Telephone,
+		Status:       driver.Status,
+		DistinctCode: driver.DistinctCode,
+		AuditAt:      driver.AuditAt,
+	}
+}
+
+func (c *driverCache) driver() *biz.Driver {
+	driver := &biz.Driver{}
+	driver.ID = c.ID
+	driver.Telephone = c.Telephone
+	driver.Status = c.Status
+	driver.DistinctCode = c.DistinctCode
+	driver.AuditAt = c.AuditAt
+	return driver
+}
+
+// 司机信息缓存的key，driver-info
+func driverCacheKey(tel string) string {
+	return "DINFO:" + tel
+}
+
+// 读取缓存的司机信息，未命中或读取失败时为 nil
+// 只有 driverCache 中的字段有值，需要其他字段时查询数据表
+func (d *Data) cachedDriver(ctx context.Context, tel string) *biz.Driver {
+	b, err := d.Rdb.Get(ctx, driverCacheKey(tel)).Bytes()
+	if err != nil {
+		return nil
+	}
+	c := &driverCache{}
+	if err := json.Unmarshal(b, c); err != nil || c.ID == 0 {
+		return nil
+	}
+	return c.driver()
+}
+
+// 缓存司机信息，失败时下次请求仍然查询数据表
+func (d *Data) cacheDriver(ctx context.Context, driver *biz.Driver) {
+	b, err := json.Marshal(toDriverCache(driver))
+	if err != nil {
+		return
+	}
+	d.Rdb.Set(ctx, driverCacheKey(driver.Telephone), b, driverCacheTTL)
+}
+
+// 删除司机信息缓存，司机表更新后调用
+func (d *Data) dropDriverCache(ctx context.Context, tel string) {
+	d.Rdb.Del(ctx, driverCacheKey(tel))
+}
+
+// 按司机id删除司机信息缓存
+func (d *Data) dropDriverCacheByID(ctx context.Context, id uint) {
+	var tel string
+	if err := d.Mdb.WithContext(ctx).Model(&biz.Driver{}).Where("id=?", id).Pluck("telephone", &tel).Error; err != nil || tel == "" {
+		return
+	}
+	d.dropDriverCache(ctx, tel)
+}
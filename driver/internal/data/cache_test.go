@@ -0,0 +1,58 @@
+package data
+
+import (
+	"database/sql"
+	"driver/internal/biz"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDriverCache(t *testing.T) {
+	audit := sql.NullTime{Time: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC), Valid: true}
+	tests := []struct {
+		name   string
+		status sql.NullString
+		audit  sql.NullTime
+	}{
+		{"听单中", sql.NullString{String: biz.DriverStatusListen, Valid: true}, audit},
+		{"未审核", sql.NullString{String: biz.DriverStatusStop, Valid: true}, sql.NullTime{}},
+		{"没有状态", sql.NullString{}, sql.NullTime{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver := &biz.Driver{}
+			driver.ID = 7
+			driver.Telephone = "13800000000"
+			driver.Status = tt.status
+			driver.DistinctCode = sql.NullString{String: "110000", Valid: true}
+			driver.AuditAt = tt.audit
+			driver.Token = sql.NullString{String: "secret-token", Valid: true}
+			driver.IdNumber = sql.NullString{String: "11010519491231002X", Valid: true}
+			driver.Name = sql.NullString{String: "张三", Valid: true}
+			b, err := json.Marshal(toDriverCache(driver))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 缓存中不能有 token、身份证号和姓名
+			for _, v := range []string{"secret-token", "11010519491231002X", "张三"} {
+				if strings.Contains(string(b), v) {
+					t.Fatalf("cache contains %q: %s", v, b)
+				}
+			}
+			c := &driverCache{}
+			if err := json.Unmarshal(b, c); err != nil {
+				t.Fatal(err)
+			}
+			got := c.driver()
+			if got.ID != driver.ID || got.Telephone != driver.Telephone || got.Status != driver.Status ||
+				got.DistinctCode != driver.DistinctCode || got.AuditAt.Valid != driver.AuditAt.Valid || !got.AuditAt.Time.Equal(driver.AuditAt.Time) {
+				t.Errorf("driver() = %+v, want %+v", got.DriverWork, driver.DriverWork)
+			}
+			if got.Token.Valid || got.IdNumber.Valid || got.Name.Valid {
+				t.Errorf("driver() has sensitive fields: %+v", got.DriverWork)
+			}
+		})
+	}
+}
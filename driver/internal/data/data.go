@@ -12,7 +12,8 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewDriverInterface)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewDriverInterface, NewOrderInterface, NewLocationInterface, NewAuditInterface, NewDocumentInterface, NewBlobStore,
+	NewClientFactory, NewVerifyCodeClient, NewOrderClient, NewValuationClient, NewSupplyReporter)
 
 // Data .
 type Data struct {
@@ -21,15 +22,11 @@ type Data struct {
 	Mdb *gorm.DB
 	// 操作Redis的客户端
 	Rdb *redis.Client
-	// 中间件服务器配置
-	cs *conf.Service
 }
 
 // NewData .
-func NewData(c *conf.Data, cs *conf.Service, logger log.Logger) (*Data, func(), error) {
-	data := &Data{
-		cs: cs,
-	}
+func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	data := &Data{}
 	// 连接redis，使用服务的配置，c就是解析之后的配置信息,此处的redis没有配置密码
 	redisURL := fmt.Sprintf("redis://%s/2?dial_timeout=%d", c.Redis.Addr, 1)
 	options, err := redis.ParseURL(redisURL)
@@ -60,7 +57,7 @@ func NewData(c *conf.Data, cs *conf.Service, logger log.Logger) (*Data, func(),
 
 func migrateTable(db *gorm.DB) {
 	// 自动迁移相关表
-	if err := db.AutoMigrate(&biz.Driver{}); err != nil {
+	if err := db.AutoMigrate(&biz.Driver{}, &biz.DriverStatusLog{}, &biz.DriverAudit{}); err != nil {
 		log.Fatal(err)
 	}
 }
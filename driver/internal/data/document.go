@@ -0,0 +1,23 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"driver/internal/biz"
+)
+
+type DocumentData struct {
+	data *Data
+}
+
+func NewDocumentInterface(data *Data) biz.DocumentInterface {
+	return &DocumentData{data: data}
+}
+
+// 保存证件的 object key
+func (dd *DocumentData) SaveDocument(ctx context.Context, driverID uint, field, key string) error {
+	defer dd.data.dropDriverCacheByID(ctx, driverID)
+	return dd.data.Mdb.WithContext(ctx).Model(&biz.Driver{}).
+		Where("id=?", driverID).
+		Update(field, sql.NullString{String: key, Valid: true}).Error
+}
@@ -5,18 +5,17 @@ import (
 	"database/sql"
 	"driver/api/verifyCode"
 	"driver/internal/biz"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"github.com/hashicorp/consul/api"
+	"gorm.io/gorm"
 	"time"
 )
 
 type DriverData struct {
 	data *Data
+	vc   verifyCode.VerifyCodeClient
 }
 
-func NewDriverInterface(data *Data) biz.DriverInterface {
-	return &DriverData{data: data}
+func NewDriverInterface(data *Data, vc verifyCode.VerifyCodeClient) biz.DriverInterface {
+	return &DriverData{data: data, vc: vc}
 }
 
 // 获取token的实现
@@ -30,11 +29,6 @@ func (dt *DriverData) GetToken(ctx context.Context, tel string) (string, error)
 	return driver.Token.String, nil
 }
 
-// 获取已存储的验证码
-func (dt *DriverData) GetSavedVerifyCode(ctx context.Context, tel string) (string, error) {
-	return dt.data.Rdb.Get(ctx, "DVC:"+tel).Result()
-}
-
 // 存储token到数据表
 func (dt *DriverData) SaveToken(ctx context.Context, tel, token string) error {
 	//先获取司机信息
@@ -50,6 +44,7 @@ func (dt *DriverData) SaveToken(ctx context.Context, tel, token string) error {
 	if err := dt.data.Mdb.Save(&driver).Error; err != nil {
 		return err
 	}
+	dt.data.dropDriverCache(ctx, tel)
 	return nil
 }
 
@@ -59,7 +54,7 @@ func (dt *DriverData) InitDriverInfo(ctx context.Context, tel string) (*biz.Driv
 	driver := biz.Driver{}
 	driver.Telephone = tel
 	driver.Status = sql.NullString{
-		String: "stop",
+		String: biz.DriverStatusStop,
 		Valid:  true,
 	}
 	if err := dt.data.Mdb.Create(&driver).Error; err != nil {
@@ -68,61 +63,86 @@ func (dt *DriverData) InitDriverInfo(ctx context.Context, tel string) (*biz.Driv
 	return &driver, nil
 }
 
-func (dt *DriverData) GetVerifyCode(ctx context.Context, tel string) (string, error) {
-	// grpc 请求
-	consulConfig := api.DefaultConfig()
-	consulConfig.Address = dt.data.cs.Consul.Address
-	consulClient, err := api.NewClient(consulConfig)
-	// 注册服务管理器
-	dis := consul.New(consulClient)
-	if err != nil {
-		return "", err
-	}
-	endpoint := "discovery:///VerifyCode"
-	conn, err := grpc.DialInsecure(
-		//ctx,
-		context.Background(),
-		grpc.WithEndpoint(endpoint), // 目标服务的名字
-		grpc.WithDiscovery(dis),     // 使用服务发现
-	)
-	if err != nil {
-		return "", err
-	}
-	//关闭
-	defer conn.Close()
-	// 2.2,发送获取验证码请求
-	client := verifyCode.NewVerifyCodeClient(conn)
-	// 作为客户端在进行远程调用
-	reply, err := client.GetVerifyCode(ctx, &verifyCode.GetVerifyCodeRequest{
-		Length: 6,
-		Type:   1,
+// 司机登录验证码的场景，与验证码服务的配置一致
+const VerifyCodeScene = "driver_login"
+
+// 由验证码服务签发并存储验证码，返回验证码和有效期（second）
+func (dt *DriverData) IssueVerifyCode(ctx context.Context, tel string) (string, int64, error) {
+	// grpc 请求，使用启动时建立的长连接
+	reply, err := dt.vc.Issue(ctx, &verifyCode.IssueRequest{
+		Scene:   VerifyCodeScene,
+		Subject: tel,
 	})
 	if err != nil {
-		return "", err
-	}
-	// 三，redis的临时存储
-	// 设置key, customer-verify-code
-	status := dt.data.Rdb.Set(ctx, "DVC:"+tel, reply.Code, 60*time.Second)
-	if _, err := status.Result(); err != nil {
-		return "", err
+		return "", 0, err
 	}
-	return reply.Code, nil
+	return reply.Code, reply.Ttl, nil
 }
 
-// 获取号码对应的验证码
-func (dt *DriverData) FetchVerifyCode(ctx context.Context, telephone string) (string, error) {
-	status := dt.data.Rdb.Get(context.Background(), "DVC:"+telephone)
-	code, err := status.Result() // status.String()
-	if err != nil {
-		return "", err
-	}
-	return code, nil
+// 由验证码服务校验验证码，校验成功后验证码失效
+func (dt *DriverData) VerifyCode(ctx context.Context, tel, code string) error {
+	_, err := dt.vc.Verify(ctx, &verifyCode.VerifyRequest{
+		Scene:   VerifyCodeScene,
+		Subject: tel,
+		Code:    code,
+	})
+	return err
 }
 
+// 获取司机信息，每个请求的 token 校验都会调用，优先读取 redis 中的缓存，未命中时查询数据表
+// 命中与否都只返回缓存的字段（id、电话、状态、区号、审核时间）
 func (dt *DriverData) FetchInfoByTel(ctx context.Context, tel string) (*biz.Driver, error) {
+	if driver := dt.data.cachedDriver(ctx, tel); driver != nil {
+		return driver, nil
+	}
 	driver := &biz.Driver{}
 	if err := dt.data.Mdb.Where("telephone=?", tel).First(driver).Error; err != nil {
 		return nil, err
 	}
-	return driver, nil
+	dt.data.cacheDriver(ctx, driver)
+	return toDriverCache(driver).driver(), nil
+}
+
+// 修改司机状态并记录变更，两者在同一事务中完成
+func (dt *DriverData) ChangeStatus(ctx context.Context, log *biz.DriverStatusLog) error {
+	defer dt.data.dropDriverCacheByID(ctx, log.DriverID)
+	return dt.data.Mdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&biz.Driver{}).
+			Where("id=? AND status=?", log.DriverID, log.From).
+			Update("status", log.To)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrDriverConflict
+		}
+		return tx.Create(log).Error
+	})
+}
+
+// 清除司机表中的token
+func (dt *DriverData) ClearToken(ctx context.Context, tel string) error {
+	defer dt.data.dropDriverCache(ctx, tel)
+	return dt.data.Mdb.WithContext(ctx).Model(&biz.Driver{}).
+		Where("telephone=?", tel).
+		Update("token", sql.NullString{Valid: false}).Error
+}
+
+// 撤销列表的key，driver-revoked-token
+func revokedTokenKey(jti string) string {
+	return "DRT:" + jti
+}
+
+// 将 JWT ID 加入撤销列表，过期后自动删除
+func (dt *DriverData) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	return dt.data.Rdb.Set(ctx, revokedTokenKey(jti), 1, ttl).Err()
+}
+
+// JWT ID 是否在撤销列表中
+func (dt *DriverData) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	n, err := dt.data.Rdb.Exists(ctx, revokedTokenKey(jti)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
 }
@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"driver/internal/biz"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+type LocationData struct {
+	data *Data
+	sr   *SupplyReporter
+}
+
+func NewLocationInterface(data *Data, sr *SupplyReporter) biz.LocationInterface {
+	return &LocationData{data: data, sr: sr}
+}
+
+// 城市内司机位置的 GEO 集合，member 为司机id
+func locationKey(city string) string {
+	return "DLOC:" + city
+}
+
+// 城市内司机位置的上报时间，zset，score 为 unix timestamp
+// GEO 集合的 member 不能单独设置过期时间，使用该集合判断位置是否过期
+func locationTimeKey(city string) string {
+	return "DLOCT:" + city
+}
+
+// 保存司机的最新位置
+func (ld *LocationData) SaveLocation(ctx context.Context, l *biz.DriverLocation) error {
+	member := strconv.FormatUint(uint64(l.DriverID), 10)
+	// 整个城市长时间没有上报时，key 自动过期
+	ttl := biz.DriverLocationTTL * time.Second
+	_, err := ld.data.Rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.GeoAdd(ctx, locationKey(l.City), &redis.GeoLocation{
+			Name:      member,
+			Longitude: l.Lng,
+			Latitude:  l.Lat,
+		})
+		pipe.ZAdd(ctx, locationTimeKey(l.City), redis.Z{
+			Score:  float64(l.LocatedAt.Unix()),
+			Member: member,
+		})
+		pipe.Expire(ctx, locationKey(l.City), ttl)
+		pipe.Expire(ctx, locationTimeKey(l.City), ttl)
+		return nil
+	})
+	return err
+}
+
+// 查询半径内的司机位置，顺便清理过期的位置
+func (ld *LocationData) SearchLocations(ctx context.Context, city string, lng, lat, radius float64, since time.Time) ([]*biz.DriverLocation, error) {
+	geos, err := ld.data.Rdb.GeoSearchLocation(ctx, locationKey(city), &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: redis.GeoSearchQuery{
+			Longitude:  lng,
+			Latitude:   lat,
+			Radius:     radius,
+			RadiusUnit: "m",
+			Sort:       "ASC",
+		},
+		WithCoord: true,
+		WithDist:  true,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	locations := []*biz.DriverLocation{}
+	if len(geos) == 0 {
+		return locations, nil
+	}
+	members := make([]string, 0, len(geos))
+	for _, g := range geos {
+		members = append(members, g.Name)
+	}
+	// 不存在的 member score 为 0，同样视为过期
+	scores, err := ld.data.Rdb.ZMScore(ctx, locationTimeKey(city), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	stale := []interface{}{}
+	for i, g := range geos {
+		if int64(scores[i]) < since.Unix() {
+			stale = append(stale, g.Name)
+			continue
+		}
+		id, err := strconv.ParseUint(g.Name, 10, 64)
+		if err != nil {
+			continue
+		}
+		locations = append(locations, &biz.DriverLocation{
+			DriverID:  uint(id),
+			City:      city,
+			Lng:       g.Longitude,
+			Lat:       g.Latitude,
+			Distance:  g.Dist,
+			LocatedAt: time.Unix(int64(scores[i]), 0),
+		})
+	}
+	if len(stale) > 0 {
+		ld.data.Rdb.ZRem(ctx, locationKey(city), stale...)
+		ld.data.Rdb.ZRem(ctx, locationTimeKey(city), stale...)
+	}
+	return locations, nil
+}
+
+// 从 ids 中筛选出状态为 listen 的司机
+func (ld *LocationData) ListeningDrivers(ctx context.Context, ids []uint) (map[uint]struct{}, error) {
+	listening := []uint{}
+	if err := ld.data.Mdb.WithContext(ctx).Model(&biz.Driver{}).
+		Where("id IN ? AND status=?", ids, biz.DriverStatusListen).
+		Pluck("id", &listening).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[uint]struct{}, len(listening))
+	for _, id := range listening {
+		result[id] = struct{}{}
+	}
+	return result, nil
+}
+
+// 上报听单司机的供给信号，用于 Valuation 服务的动态调价
+// 由 SupplyReporter 汇总后定时批量上报
+func (ld *LocationData) ReportSupply(ctx context.Context, l *biz.DriverLocation) error {
+	ld.sr.Add(l)
+	return nil
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"context"
+	"driver/api/order"
+	"driver/internal/biz"
+)
+
+type OrderData struct {
+	data *Data
+	oc   order.OrderClient
+}
+
+func NewOrderInterface(data *Data, oc order.OrderClient) biz.OrderInterface {
+	return &OrderData{data: data, oc: oc}
+}
+
+func (od *OrderData) AcceptOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error) {
+	return orderInfo(od.oc.AcceptOrder(ctx, &order.AcceptOrderReq{
+		Id:       uint64(orderID),
+		DriverId: uint64(driverID),
+	}))
+}
+
+func (od *OrderData) ArriveOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error) {
+	return orderInfo(od.oc.ArriveOrder(ctx, &order.ArriveOrderReq{
+		Id:       uint64(orderID),
+		DriverId: uint64(driverID),
+	}))
+}
+
+func (od *OrderData) StartOrder(ctx context.Context, orderID, driverID uint) (*order.OrderInfo, error) {
+	return orderInfo(od.oc.StartOrder(ctx, &order.StartOrderReq{
+		Id:       uint64(orderID),
+		DriverId: uint64(driverID),
+	}))
+}
+
+func (od *OrderData) FinishOrder(ctx context.Context, orderID, driverID uint, odometer int64) (*order.OrderInfo, error) {
+	return orderInfo(od.oc.FinishOrder(ctx, &order.FinishOrderReq{
+		Id:       uint64(orderID),
+		DriverId: uint64(driverID),
+		Odometer: odometer,
+	}))
+}
+
+// 获取派给司机的订单
+func (od *OrderData) GetOffer(ctx context.Context, driverID uint) (*order.OfferReply, error) {
+	return od.oc.GetOffer(ctx, &order.GetOfferReq{
+		DriverId: uint64(driverID),
+	})
+}
+
+// 取出返回单个订单的调用结果
+func orderInfo(reply *order.OrderReply, err error) (*order.OrderInfo, error) {
+	if err != nil {
+		return nil, err
+	}
+	return reply.Order, nil
+}
@@ -0,0 +1,104 @@
+package data
+
+import (
+	"context"
+	"driver/api/valuation"
+	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 供给信号的上报间隔，需要明显小于 Valuation 服务的 supply_window
+const SupplyReportInterval = 10 * time.Second
+
+// 单次批量上报的最大信号数，与 Valuation 服务的限制一致
+const SupplyReportBatch = 500
+
+// 单次批量上报的超时
+const SupplyReportTimeout = 5 * time.Second
+
+// 听单司机供给信号的批量上报
+// 位置上报时只在内存中记录每个司机的最新位置，定时合并为 ReportSurgeSignals 调用，不阻塞位置上报
+// 上报失败时丢弃本批，司机的下一次位置上报会重新记录
+type SupplyReporter struct {
+	vc      valuation.ValuationClient
+	log     *log.Helper
+	mu      sync.Mutex
+	pending map[uint]*biz.DriverLocation
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// SupplyReporter 构造器，cleanup 时上报剩余的信号
+func NewSupplyReporter(vc valuation.ValuationClient, logger log.Logger) (*SupplyReporter, func()) {
+	sr := &SupplyReporter{
+		vc:      vc,
+		log:     log.NewHelper(logger),
+		pending: map[uint]*biz.DriverLocation{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go sr.run()
+	cleanup := func() {
+		close(sr.stop)
+		<-sr.done
+	}
+	return sr, cleanup
+}
+
+// 记录司机的最新位置，等待下一次上报
+func (sr *SupplyReporter) Add(l *biz.DriverLocation) {
+	sr.mu.Lock()
+	sr.pending[l.DriverID] = l
+	sr.mu.Unlock()
+}
+
+func (sr *SupplyReporter) run() {
+	defer close(sr.done)
+	ticker := time.NewTicker(SupplyReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			sr.flush()
+		case <-sr.stop:
+			sr.flush()
+			return
+		}
+	}
+}
+
+// 取出已记录的位置，分批上报
+func (sr *SupplyReporter) flush() {
+	sr.mu.Lock()
+	pending := sr.pending
+	sr.pending = make(map[uint]*biz.DriverLocation, len(pending))
+	sr.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	signals := make([]*valuation.ReportSurgeSignalReq, 0, len(pending))
+	for _, l := range pending {
+		signals = append(signals, &valuation.ReportSurgeSignalReq{
+			City:     l.City,
+			Location: strconv.FormatFloat(l.Lng, 'f', 6, 64) + "," + strconv.FormatFloat(l.Lat, 'f', 6, 64),
+			Kind:     "supply",
+			Member:   strconv.FormatUint(uint64(l.DriverID), 10),
+			Active:   true,
+		})
+	}
+	for start := 0; start < len(signals); start += SupplyReportBatch {
+		end := start + SupplyReportBatch
+		if end > len(signals) {
+			end = len(signals)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), SupplyReportTimeout)
+		_, err := sr.vc.ReportSurgeSignals(ctx, &valuation.ReportSurgeSignalsReq{Signals: signals[start:end]})
+		cancel()
+		if err != nil {
+			sr.log.Warnf("report %d supply signals: %v", end-start, err)
+		}
+	}
+}
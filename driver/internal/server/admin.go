@@ -0,0 +1,41 @@
+package server
+
+import (
+	"common/auth"
+	"context"
+	"driver/internal/conf"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+)
+
+// 仅供运营后台调用的接口
+var adminOperations = map[string]struct{}{
+	"/api.driver.Driver/ListAudits":   {},
+	"/api.driver.Driver/ApproveAudit": {},
+	"/api.driver.Driver/RejectAudit":  {},
+}
+
+// 仅供内部服务调用的接口
+var serviceOperations = map[string]struct{}{
+	"/api.driver.Driver/NearbyDrivers": {},
+}
+
+func adminOperation(ctx context.Context, operation string) bool {
+	_, ok := adminOperations[operation]
+	return ok
+}
+
+func serviceOperation(ctx context.Context, operation string) bool {
+	_, ok := serviceOperations[operation]
+	return ok
+}
+
+// 审核接口的认证：校验运营后台签发的 token，sub 作为审核人
+func AdminToken(ac *conf.Auth) middleware.Middleware {
+	return selector.Server(auth.Admin(ac.GetAdminSecret())).Match(adminOperation).Build()
+}
+
+// 内部接口的认证：校验服务间调用的 token，只在 gRPC 中提供
+func ServiceToken(ac *conf.Auth) middleware.Middleware {
+	return selector.Server(auth.Service(ac.GetServiceSecret())).Match(serviceOperation).Build()
+}
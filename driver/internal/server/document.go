@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"driver/internal/biz"
+	"driver/internal/service"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"io"
+	nethttp "net/http"
+)
+
+const OperationDriverUploadDocument = "/api.driver.Driver/UploadDocument"
+
+// multipart 请求中除文件外的其他部分的大小余量
+const documentFormOverhead = 1 << 20
+
+// 上传证件照片，multipart/form-data，文件字段名为 file
+// 与 proto 生成的路由一样经过 http 中间件（JWT、DriverToken），认证通过后才读取请求体
+func UploadDocument(driverService *service.DriverService) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		kind := ctx.Vars().Get("kind")
+		req := ctx.Request()
+		http.SetOperation(ctx, OperationDriverUploadDocument)
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			req.Body = nethttp.MaxBytesReader(ctx.Response(), req.Body, biz.DocumentSizeMax+documentFormOverhead)
+			// 读取失败（缺少文件、超过大小）时 data 为空，由业务逻辑返回错误信息
+			var data []byte
+			if file, _, err := req.FormFile("file"); err == nil {
+				data, _ = io.ReadAll(io.LimitReader(file, biz.DocumentSizeMax+1))
+				_ = file.Close()
+			}
+			if req.MultipartForm != nil {
+				defer func() {
+					_ = req.MultipartForm.RemoveAll()
+				}()
+			}
+			return driverService.UploadDocument(c, kind, data)
+		})
+		out, err := h(ctx, nil)
+		if err != nil {
+			return err
+		}
+		return ctx.Result(200, out)
+	}
+}
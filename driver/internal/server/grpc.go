@@ -1,10 +1,14 @@
 package server
 
 import (
+	"common/limit"
 	"driver/api/driver"
 	v1 "driver/api/helloworld/v1"
+	"driver/api/verifyCode"
 	"driver/internal/conf"
+	"driver/internal/data"
 	"driver/internal/service"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -12,10 +16,18 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, driverService *service.DriverService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, auth *conf.Auth, greeter *service.GreeterService, driverService *service.DriverService, vc verifyCode.VerifyCodeClient, tp *limit.TrustedProxies, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			// 审核接口的运营后台认证
+			AdminToken(auth),
+			// 内部接口的服务间调用认证
+			ServiceToken(auth),
+			// 司机接口与 HTTP 相同的认证和限流，流式接口在服务中校验 token
+			DriverAuth(driverService),
+			selector.Server(verifyCodeLimit(vc, data.VerifyCodeScene, tp)).
+				Path("/api.driver.Driver/GetVerifyCode").Build(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -1,15 +1,14 @@
 package server
 
 import (
-	"context"
+	"common/limit"
 	"driver/api/driver"
 	v1 "driver/api/helloworld/v1"
-	"driver/internal/biz"
+	"driver/api/verifyCode"
 	"driver/internal/conf"
+	"driver/internal/data"
 	"driver/internal/service"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
-	jwtv5 "github.com/golang-jwt/jwt/v5"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -17,28 +16,15 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, driverService *service.DriverService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, driverService *service.DriverService, vc verifyCode.VerifyCodeClient, tp *limit.TrustedProxies, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			// JWT 中间件
-			selector.Server(
-				jwt.Server(func(token *jwtv5.Token) (interface{}, error) { // 这个中间件有什么用？
-					return []byte(biz.SecretKey), nil // 用于验证客户端发送的JWT令牌；
-				}),
-				DriverToken(driverService),
-			).Match(func(ctx context.Context, operation string) bool {
-				// 记录不需要的校验的
-				noJWT := map[string]struct{}{
-					"/api.driver.Driver/Login":         {},
-					"/api.driver.Driver/GetVerifyCode": {},
-					"/api.driver.Driver/SubmitPhone":   {},
-				}
-				if _, exists := noJWT[operation]; exists {
-					return false
-				}
-				return true
-			}).Build(),
+			DriverAuth(driverService),
+			// 验证码发送的限流
+			selector.Server(verifyCodeLimit(vc, data.VerifyCodeScene, tp)).
+				Path("/api.driver.Driver/GetVerifyCode").Build(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -53,5 +39,7 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, driverServic
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	driver.RegisterDriverHTTPServer(srv, driverService)
+	// 证件上传
+	srv.Route("/").POST("/driver/document/{kind}", UploadDocument(driverService))
 	return srv
 }
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"common/limit"
+	"context"
+	"driver/api/verifyCode"
+	"driver/internal/biz"
+	"driver/internal/conf"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// 受信任的反向代理，配置错误时拒绝启动
+func NewTrustedProxies(c *conf.Server) (*limit.TrustedProxies, error) {
+	return limit.NewTrustedProxies(c.GetTrustedProxies())
+}
+
+// 验证码发送的限流中间件，号码、IP、设备的计数由验证码服务完成
+func verifyCodeLimit(vc verifyCode.VerifyCodeClient, scene string, tp *limit.TrustedProxies) middleware.Middleware {
+	return limit.VerifyCode(func(ctx context.Context, subject, ip, device string) error {
+		_, err := vc.Allow(ctx, &verifyCode.AllowRequest{
+			Scene:   scene,
+			Subject: subject,
+			Ip:      ip,
+			Device:  device,
+		})
+		return err
+	}, biz.ValidTelephone, tp)
+}
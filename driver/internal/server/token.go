@@ -6,43 +6,58 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
-	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	jwtv5 "github.com/golang-jwt/jwt/v5"
-	"strings"
 )
 
+// 不需要司机 token 的接口
+var publicOperations = map[string]struct{}{
+	"/api.driver.Driver/Login":         {},
+	"/api.driver.Driver/GetVerifyCode": {},
+	"/api.driver.Driver/SubmitPhone":   {},
+}
+
+// 需要司机 token 的接口：公开接口、运营后台接口和内部接口以外的全部接口
+func driverOperation(ctx context.Context, operation string) bool {
+	if _, ok := publicOperations[operation]; ok {
+		return false
+	}
+	return !adminOperation(ctx, operation) && !serviceOperation(ctx, operation)
+}
+
+// 司机的认证，HTTP 和 gRPC 共用：JWT 校验后再与保存的 token 比对
+func DriverAuth(driverService *service.DriverService) middleware.Middleware {
+	return selector.Server(
+		jwt.Server(func(token *jwtv5.Token) (interface{}, error) {
+			return driverService.Bz.Secret(), nil // 用于验证客户端发送的JWT令牌；
+		}),
+		DriverToken(driverService),
+	).Match(driverOperation).Build()
+}
+
 // 返回中间件的函数
 func DriverToken(service *service.DriverService) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			// 1.校验JWT，获取其中的司机标识tel
+			// 1.获取已通过JWT中间件校验的claims，其中 sub 为司机标识tel，jti 为token的id
 			claims, ok := jwt.FromContext(ctx)
 			if !ok {
 				return nil, errors.Unauthorized("Unauthorized", "claims not found")
 			}
-			claimsMap := claims.(jwtv5.MapClaims)
-			tel := claimsMap["jti"]
-			// 2.利用tel，获取存储在司机表（MySQL）中的token；
-			token, err := service.Bz.DI.GetToken(ctx, tel.(string))
-			if err != nil {
-				return nil, errors.Unauthorized("Unauthorized", "driver token not found")
-			}
-			// 3.比对两个token(和请求头中的）
-			header, _ := transport.FromServerContext(ctx)
-			auths := strings.SplitN(header.RequestHeader().Get("Authorization"), " ", 2)
-			reqToken := auths[1]
-			if token != reqToken {
-				return nil, errors.Unauthorized("Unauthorized", "token was updated")
+			claimsMap, ok := claims.(jwtv5.MapClaims)
+			if !ok {
+				return nil, errors.Unauthorized("Unauthorized", "claims invalid")
 			}
-			// 4.记录登录司机信息
-			driver, err := service.Bz.DI.FetchInfoByTel(ctx, tel.(string))
+			// 2.利用jti，检查token是否在撤销列表（redis）中（退出登录、在其他设备登录）
+			// 3.利用tel，获取登录司机信息
+			driver, err := service.Bz.CheckClaims(ctx, claimsMap)
 			if err != nil {
-				return nil, errors.Unauthorized("Unauthorized", "driver was found")
+				return nil, err
 			}
 			// 基于当前的ctx，构建新的带有值的ctx
 			ctxWithDriver := context.WithValue(ctx, "driver", driver)
 			//ctxWithDriver.Value("driver")
-			// 5.jwt校验通过
+			// 4.jwt校验通过
 			return handler(ctxWithDriver, req)
 		}
 	}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"common/auth"
+	"context"
+	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "driver/api/driver"
+)
+
+// IDNoCheck 校验身份证号码，通过后保存到登录司机并进入审核队列
+func (s *DriverService) IDNoCheck(ctx context.Context, req *pb.IDNoCheckReq) (*pb.IDNoCheckResp, error) {
+	audit, err := s.Ab.SubmitIDNo(ctx, ctxDriver(ctx), req.Name, req.Idno)
+	if err != nil {
+		return &pb.IDNoCheckResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.IDNoCheckResp{
+		Code:    0,
+		Message: "已提交审核",
+		Status:  audit.Status,
+		AuditId: uint64(audit.ID),
+	}, nil
+}
+
+// ListAudits 审核队列
+func (s *DriverService) ListAudits(ctx context.Context, req *pb.ListAuditsReq) (*pb.ListAuditsResp, error) {
+	audits, total, err := s.Ab.ListAudits(ctx, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListAuditsResp{
+		Audits: make([]*pb.AuditInfo, 0, len(audits)),
+		Total:  total,
+	}
+	for _, audit := range audits {
+		resp.Audits = append(resp.Audits, toAuditInfo(audit))
+	}
+	return resp, nil
+}
+
+// ApproveAudit 审核通过
+func (s *DriverService) ApproveAudit(ctx context.Context, req *pb.ApproveAuditReq) (*pb.AuditResp, error) {
+	audit, err := s.Ab.ApproveAudit(ctx, uint(req.AuditId), auth.Operator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditResp{Audit: toAuditInfo(audit)}, nil
+}
+
+// RejectAudit 审核驳回
+func (s *DriverService) RejectAudit(ctx context.Context, req *pb.RejectAuditReq) (*pb.AuditResp, error) {
+	audit, err := s.Ab.RejectAudit(ctx, uint(req.AuditId), auth.Operator(ctx), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditResp{Audit: toAuditInfo(audit)}, nil
+}
+
+// 审核模型 DO => DTO
+func toAuditInfo(audit *biz.DriverAudit) *pb.AuditInfo {
+	info := &pb.AuditInfo{
+		Id:        uint64(audit.ID),
+		DriverId:  uint64(audit.DriverID),
+		Name:      audit.Name,
+		IdNumber:  audit.IdNumber,
+		Status:    audit.Status,
+		Reason:    audit.Reason.String,
+		Operator:  audit.Operator.String,
+		CreatedAt: audit.CreatedAt.Unix(),
+	}
+	if audit.AuditedAt.Valid {
+		info.AuditedAt = audit.AuditedAt.Time.Unix()
+	}
+	if audit.Driver != nil {
+		info.Telephone = audit.Driver.Telephone
+		info.IdImageA = audit.Driver.IdImageA.String
+		info.LicenseImageA = audit.Driver.LicenseImageA.String
+		info.LicenseImageB = audit.Driver.LicenseImageB.String
+	}
+	return info
+}
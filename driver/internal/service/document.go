@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "driver/api/driver"
+)
+
+// UploadDocument 上传证件照片
+func (s *DriverService) UploadDocument(ctx context.Context, kind string, data []byte) (*pb.UploadDocumentResp, error) {
+	key, err := s.Fb.Upload(ctx, ctxDriver(ctx), kind, data)
+	if err != nil {
+		return &pb.UploadDocumentResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			Kind:    kind,
+		}, nil
+	}
+	return &pb.UploadDocumentResp{
+		Code:    0,
+		Message: "上传成功",
+		Kind:    kind,
+		Key:     key,
+	}, nil
+}
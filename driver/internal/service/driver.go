@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
 	"log"
 	"time"
 
@@ -12,22 +14,25 @@ import (
 type DriverService struct {
 	pb.UnimplementedDriverServer
 	Bz *biz.DriverBiz
+	Ob *biz.OrderBiz
+	Lb *biz.LocationBiz
+	Ab *biz.AuditBiz
+	Fb *biz.DocumentBiz
 }
 
-func NewDriverService(bz *biz.DriverBiz) *DriverService {
+func NewDriverService(bz *biz.DriverBiz, ob *biz.OrderBiz, lb *biz.LocationBiz, ab *biz.AuditBiz, fb *biz.DocumentBiz) *DriverService {
 	return &DriverService{
 		Bz: bz,
+		Ob: ob,
+		Lb: lb,
+		Ab: ab,
+		Fb: fb,
 	}
 }
 
-// IDNoCheck 校验身份证号码
-func (s *DriverService) IDNoCheck(ctx context.Context, req *pb.IDNoCheckReq) (*pb.IDNoCheckResp, error) {
-	return &pb.IDNoCheckResp{}, nil
-}
-
 func (s *DriverService) GetVerifyCode(ctx context.Context, req *pb.GetVerifyCodeReq) (*pb.GetVerifyCodeResp, error) {
 	// 获取验证码
-	code, err := s.Bz.GetVerifyCode(ctx, req.Telephone)
+	code, life, err := s.Bz.GetVerifyCode(ctx, req.Telephone)
 	if err != nil {
 		return &pb.GetVerifyCodeResp{
 			Code:    1,
@@ -40,7 +45,7 @@ func (s *DriverService) GetVerifyCode(ctx context.Context, req *pb.GetVerifyCode
 		Message:        "SUCCESS",
 		VerifyCode:     code,
 		VerifyCodeTime: time.Now().Unix(),
-		VerifyCodeLife: 60,
+		VerifyCodeLife: int32(life),
 	}, nil
 }
 
@@ -78,6 +83,31 @@ func (s *DriverService) Login(ctx context.Context, req *pb.LoginReq) (*pb.LoginR
 		Message:       "司机登录成功",
 		Token:         token,
 		TokenCreateAt: time.Now().Unix(),
-		TokenLife:     biz.DriverTokenLife,
+		TokenLife:     int32(s.Bz.TokenLife().Seconds()),
+	}, nil
+}
+
+// Logout 退出登录
+func (s *DriverService) Logout(ctx context.Context, req *pb.LogoutReq) (*pb.LogoutResp, error) {
+	// 一，获取当前token的claims
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return &pb.LogoutResp{
+			Code:    1,
+			Message: "Token获取失败",
+		}, nil
+	}
+	claimsMap, _ := claims.(jwtv5.MapClaims)
+	// 二，撤销token，并清除司机表中的token
+	if err := s.Bz.Logout(ctx, ctxDriver(ctx), claimsMap); err != nil {
+		return &pb.LogoutResp{
+			Code:    1,
+			Message: "Token删除失败",
+		}, nil
+	}
+	// 三，成功，响应
+	return &pb.LogoutResp{
+		Code:    0,
+		Message: "logout success",
 	}, nil
 }
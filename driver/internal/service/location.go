@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strings"
+
+	pb "driver/api/driver"
+)
+
+// ReportLocation 上报位置
+func (s *DriverService) ReportLocation(ctx context.Context, req *pb.ReportLocationReq) (*pb.ReportLocationResp, error) {
+	if err := s.Lb.ReportLocation(ctx, ctxDriver(ctx), req.City, req.Lng, req.Lat); err != nil {
+		return &pb.ReportLocationResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	return &pb.ReportLocationResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Count:   1,
+	}, nil
+}
+
+// ReportLocationStream 持续上报位置
+// grpc 服务器没有 JWT 中间件，由流的 metadata 中的 authorization 完成认证
+func (s *DriverService) ReportLocationStream(stream pb.Driver_ReportLocationStreamServer) error {
+	ctx := stream.Context()
+	driver, err := s.streamDriver(ctx)
+	if err != nil {
+		return err
+	}
+	var count int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.ReportLocationResp{
+				Code:    0,
+				Message: "SUCCESS",
+				Count:   count,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		// 单个位置不合法时跳过，不中断整个流
+		if err := s.Lb.ReportLocation(ctx, driver, req.City, req.Lng, req.Lat); err != nil {
+			log.Context(ctx).Warnf("driver %d report location: %v", driver.ID, err)
+			continue
+		}
+		count++
+	}
+}
+
+// NearbyDrivers 附近的听单司机
+func (s *DriverService) NearbyDrivers(ctx context.Context, req *pb.NearbyDriversReq) (*pb.NearbyDriversResp, error) {
+	locations, err := s.Lb.NearbyDrivers(ctx, req.City, req.Lng, req.Lat, req.Radius, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.NearbyDriversResp{
+		Drivers: make([]*pb.NearbyDriver, 0, len(locations)),
+	}
+	for _, l := range locations {
+		resp.Drivers = append(resp.Drivers, &pb.NearbyDriver{
+			DriverId:  uint64(l.DriverID),
+			Lng:       l.Lng,
+			Lat:       l.Lat,
+			Distance:  l.Distance,
+			LocatedAt: l.LocatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// 从流的 metadata 中获取 token 并校验，返回登录的司机
+func (s *DriverService) streamDriver(ctx context.Context) (*biz.Driver, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	auths := strings.SplitN(strings.Join(md.Get("authorization"), ""), " ", 2)
+	if len(auths) != 2 || !strings.EqualFold(auths[0], "Bearer") {
+		return nil, errors.Unauthorized("Unauthorized", "token not found")
+	}
+	return s.Bz.CheckToken(ctx, auths[1])
+}
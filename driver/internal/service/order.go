@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "driver/api/driver"
+)
+
+// GetOffer 获取派单
+func (s *DriverService) GetOffer(ctx context.Context, req *pb.GetOfferReq) (*pb.GetOfferResp, error) {
+	reply, err := s.Ob.GetOffer(ctx, ctxDriver(ctx))
+	if err != nil {
+		return &pb.GetOfferResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+		}, nil
+	}
+	if reply.Order == nil {
+		return &pb.GetOfferResp{
+			Code:    0,
+			Message: "暂无派单",
+		}, nil
+	}
+	return &pb.GetOfferResp{
+		Code:        0,
+		Message:     "SUCCESS",
+		OrderId:     reply.Order.Id,
+		Origin:      reply.Order.Origin,
+		Destination: reply.Order.Destination,
+		Price:       reply.Order.Price,
+		ExpireAt:    reply.ExpireAt,
+	}, nil
+}
+
+// AcceptOrder 接单
+func (s *DriverService) AcceptOrder(ctx context.Context, req *pb.AcceptOrderReq) (*pb.AcceptOrderResp, error) {
+	info, err := s.Ob.AcceptOrder(ctx, ctxDriver(ctx), uint(req.OrderId))
+	if err != nil {
+		return &pb.AcceptOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.AcceptOrderResp{
+		Code:    0,
+		Message: "接单成功",
+		OrderId: info.Id,
+		Status:  info.Status,
+	}, nil
+}
+
+// ArriveOrder 到达起点
+func (s *DriverService) ArriveOrder(ctx context.Context, req *pb.ArriveOrderReq) (*pb.ArriveOrderResp, error) {
+	info, err := s.Ob.ArriveOrder(ctx, ctxDriver(ctx), uint(req.OrderId))
+	if err != nil {
+		return &pb.ArriveOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.ArriveOrderResp{
+		Code:    0,
+		Message: "已到达起点",
+		OrderId: info.Id,
+		Status:  info.Status,
+	}, nil
+}
+
+// StartOrder 开始代驾
+func (s *DriverService) StartOrder(ctx context.Context, req *pb.StartOrderReq) (*pb.StartOrderResp, error) {
+	info, err := s.Ob.StartOrder(ctx, ctxDriver(ctx), uint(req.OrderId))
+	if err != nil {
+		return &pb.StartOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.StartOrderResp{
+		Code:    0,
+		Message: "代驾开始",
+		OrderId: info.Id,
+		Status:  info.Status,
+	}, nil
+}
+
+// FinishOrder 结束代驾
+func (s *DriverService) FinishOrder(ctx context.Context, req *pb.FinishOrderReq) (*pb.FinishOrderResp, error) {
+	info, err := s.Ob.FinishOrder(ctx, ctxDriver(ctx), uint(req.OrderId), req.Odometer)
+	if err != nil {
+		return &pb.FinishOrderResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			OrderId: req.OrderId,
+		}, nil
+	}
+	return &pb.FinishOrderResp{
+		Code:       0,
+		Message:    "代驾结束",
+		OrderId:    info.Id,
+		Status:     info.Status,
+		FinalPrice: info.FinalPrice,
+	}, nil
+}
+
+// 获取 DriverToken 中间件记录在 ctx 中的登录司机
+func ctxDriver(ctx context.Context) *biz.Driver {
+	driver, _ := ctx.Value("driver").(*biz.Driver)
+	if driver == nil {
+		return &biz.Driver{}
+	}
+	return driver
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"driver/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "driver/api/driver"
+)
+
+// GoOnline 上线
+func (s *DriverService) GoOnline(ctx context.Context, req *pb.SetStatusReq) (*pb.SetStatusResp, error) {
+	return s.setStatus(ctx, biz.DriverEventOnline)
+}
+
+// StartListen 开始听单
+func (s *DriverService) StartListen(ctx context.Context, req *pb.SetStatusReq) (*pb.SetStatusResp, error) {
+	return s.setStatus(ctx, biz.DriverEventListen)
+}
+
+// PauseListen 暂停听单
+func (s *DriverService) PauseListen(ctx context.Context, req *pb.SetStatusReq) (*pb.SetStatusResp, error) {
+	return s.setStatus(ctx, biz.DriverEventPause)
+}
+
+// GoOffline 下线
+func (s *DriverService) GoOffline(ctx context.Context, req *pb.SetStatusReq) (*pb.SetStatusResp, error) {
+	return s.setStatus(ctx, biz.DriverEventOffline)
+}
+
+// 根据事件修改登录司机的状态
+func (s *DriverService) setStatus(ctx context.Context, event string) (*pb.SetStatusResp, error) {
+	driver := ctxDriver(ctx)
+	status, err := s.Bz.SetStatus(ctx, driver, event)
+	if err != nil {
+		return &pb.SetStatusResp{
+			Code:    1,
+			Message: errors.FromError(err).Message,
+			Status:  driver.Status.String,
+		}, nil
+	}
+	return &pb.SetStatusResp{
+		Code:    0,
+		Message: "SUCCESS",
+		Status:  status,
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -36,10 +37,10 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(cs *conf.Service, logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 	// 一，获取consul客户端
 	consulConfig := api.DefaultConfig()
-	consulConfig.Address = "192.168.43.144:8500"
+	consulConfig.Address = cs.Consul.Address
 	consulClient, err := api.NewClient(consulConfig)
 	if err != nil {
 		log.Fatal(err)
@@ -75,6 +76,8 @@ func main() {
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
+			// 环境变量，去掉 LAOMADJ_ 前缀后用于替换配置文件中的 ${NAME:default}
+			env.NewSource("LAOMADJ_"),
 		),
 	)
 	defer c.Close()
@@ -88,7 +91,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Service, bc.Server, bc.Data, bc.Map, logger)
 	if err != nil {
 		panic(err)
 	}
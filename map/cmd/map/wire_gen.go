@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, confMap *conf.Map, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,11 +31,20 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	mapServiceBiz := biz.NewMapServiceBiz(logger)
-	mapServiceService := service.NewMapServiceService(mapServiceBiz)
+	v, err := data.NewRouteProviders(confMap, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	routeCache := data.NewRouteCache(dataData)
+	mapServiceBiz := biz.NewMapServiceBiz(v, routeCache, confMap, logger)
+	v2 := data.NewPlaceProviders(v)
+	placeCache := data.NewPlaceCache(dataData)
+	placeBiz := biz.NewPlaceBiz(v2, placeCache, confMap, logger)
+	mapServiceService := service.NewMapServiceService(mapServiceBiz, placeBiz)
 	grpcServer := server.NewGRPCServer(confServer, greeterService, mapServiceService, logger)
 	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	app := newApp(confService, logger, grpcServer, httpServer)
 	return app, func() {
 		cleanup()
 	}, nil
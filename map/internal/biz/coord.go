@@ -0,0 +1,59 @@
+package biz
+
+import "math"
+
+// GCJ-02 偏移使用的 Krasovsky 1940 椭球参数
+const (
+	gcjAxis = 6378245.0
+	gcjEE   = 0.00669342162296594323
+)
+
+// GCJ-02 转 WGS-84 的迭代次数，3 次后误差小于 1e-7 度
+const gcjInverseRounds = 3
+
+// WGS-84 转 GCJ-02（国测局坐标），境外的坐标不偏移
+func WGS84ToGCJ02(lng, lat float64) (float64, float64) {
+	if outOfChina(lng, lat) {
+		return lng, lat
+	}
+	dLng, dLat := gcjOffset(lng, lat)
+	return lng + dLng, lat + dLat
+}
+
+// GCJ-02 转 WGS-84，偏移没有解析的逆运算，按 WGS84ToGCJ02 迭代修正
+func GCJ02ToWGS84(lng, lat float64) (float64, float64) {
+	if outOfChina(lng, lat) {
+		return lng, lat
+	}
+	wLng, wLat := lng, lat
+	for i := 0; i < gcjInverseRounds; i++ {
+		gLng, gLat := WGS84ToGCJ02(wLng, wLat)
+		wLng, wLat = wLng-(gLng-lng), wLat-(gLat-lat)
+	}
+	return wLng, wLat
+}
+
+// 粗略的中国境外判断，与 GCJ-02 的公开实现一致
+func outOfChina(lng, lat float64) bool {
+	return lng < 72.004 || lng > 137.8347 || lat < 0.8293 || lat > 55.8271
+}
+
+// WGS-84 坐标在 GCJ-02 中的偏移，单位为度
+func gcjOffset(lng, lat float64) (float64, float64) {
+	x, y := lng-105.0, lat-35.0
+	dLat := -100.0 + 2.0*x + 3.0*y + 0.2*y*y + 0.1*x*y + 0.2*math.Sqrt(math.Abs(x))
+	dLat += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	dLat += (20.0*math.Sin(y*math.Pi) + 40.0*math.Sin(y/3.0*math.Pi)) * 2.0 / 3.0
+	dLat += (160.0*math.Sin(y/12.0*math.Pi) + 320*math.Sin(y*math.Pi/30.0)) * 2.0 / 3.0
+	dLng := 300.0 + x + 2.0*y + 0.1*x*x + 0.1*x*y + 0.1*math.Sqrt(math.Abs(x))
+	dLng += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	dLng += (20.0*math.Sin(x*math.Pi) + 40.0*math.Sin(x/3.0*math.Pi)) * 2.0 / 3.0
+	dLng += (150.0*math.Sin(x/12.0*math.Pi) + 300.0*math.Sin(x/30.0*math.Pi)) * 2.0 / 3.0
+	radLat := lat / 180.0 * math.Pi
+	magic := math.Sin(radLat)
+	magic = 1 - gcjEE*magic*magic
+	sqrtMagic := math.Sqrt(magic)
+	dLat = (dLat * 180.0) / ((gcjAxis * (1 - gcjEE)) / (magic * sqrtMagic) * math.Pi)
+	dLng = (dLng * 180.0) / (gcjAxis / sqrtMagic * math.Cos(radLat) * math.Pi)
+	return dLng, dLat
+}
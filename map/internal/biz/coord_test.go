@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWGS84ToGCJ02(t *testing.T) {
+	tests := []struct {
+		name             string
+		lng, lat         float64
+		wantLng, wantLat float64
+	}{
+		// 天安门，国内的偏移约为几百米
+		{"北京", 116.391244, 39.907446, 116.397486, 39.908849},
+		{"境外不偏移", 2.294481, 48.858370, 2.294481, 48.858370},
+	}
+	for _, tt := range tests {
+		lng, lat := WGS84ToGCJ02(tt.lng, tt.lat)
+		if math.Abs(lng-tt.wantLng) > 1e-5 || math.Abs(lat-tt.wantLat) > 1e-5 {
+			t.Errorf("%s: WGS84ToGCJ02 = %.6f,%.6f, want %.6f,%.6f", tt.name, lng, lat, tt.wantLng, tt.wantLat)
+		}
+	}
+}
+
+func TestGCJ02ToWGS84(t *testing.T) {
+	points := [][2]float64{
+		{116.397128, 39.916527},
+		{121.473701, 31.230416},
+		{113.264385, 23.129112},
+		{87.617733, 43.792818},
+		{-73.985428, 40.748817},
+	}
+	for _, p := range points {
+		// 转换后再转回，误差小于 1e-7 度（约 1cm）
+		lng, lat := GCJ02ToWGS84(p[0], p[1])
+		gLng, gLat := WGS84ToGCJ02(lng, lat)
+		if math.Abs(gLng-p[0]) > 1e-7 || math.Abs(gLat-p[1]) > 1e-7 {
+			t.Errorf("GCJ02ToWGS84(%v, %v) = %v,%v, round trip %v,%v", p[0], p[1], lng, lat, gLng, gLat)
+		}
+	}
+}
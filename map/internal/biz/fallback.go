@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"expvar"
+	"map/internal/conf"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 估算路线的服务商名称
+const RouteProviderFallback = "fallback"
+
+// 估算参数的默认值，配置缺失时使用
+const FallbackDetourDefault = 1.4
+const FallbackSpeedDefault = 25.0 // km/h
+
+// 估算路线的次数，通过 HTTP 的 /debug/vars 查看
+var routeFallbackStats = expvar.NewInt("route_fallback")
+
+// 所有服务商都不可用时的路线估算
+// 行驶距离 = 直线距离 × 城市的绕行系数，行驶时长 = 行驶距离 / 出发时刻的平均车速
+type RouteEstimator struct {
+	detour     float64
+	cityDetour map[string]float64
+	speeds     [24]float64 // 单位 m/s
+	loc        *time.Location
+}
+
+// RouteEstimator 构造器，未启用时返回 nil
+func NewRouteEstimator(fc *conf.Map_Fallback) *RouteEstimator {
+	if !fc.GetEnabled() {
+		return nil
+	}
+	re := &RouteEstimator{
+		detour:     fc.GetDetour(),
+		cityDetour: fc.GetCityDetour(),
+		loc:        time.Local,
+	}
+	if re.detour < 1 {
+		re.detour = FallbackDetourDefault
+	}
+	if loc, err := time.LoadLocation(fc.GetTimezone()); err == nil && fc.GetTimezone() != "" {
+		re.loc = loc
+	}
+	speed := fc.GetSpeed()
+	if speed <= 0 {
+		speed = FallbackSpeedDefault
+	}
+	hourly := fc.GetHourlySpeed()
+	for h := range re.speeds {
+		s := speed
+		if len(hourly) >= 24 && hourly[h] > 0 {
+			s = hourly[h]
+		}
+		re.speeds[h] = s / 3.6
+	}
+	return re
+}
+
+// 估算路线，依次经过途经点，需要时返回直线的 polyline
+func (re *RouteEstimator) Estimate(q *RouteQuery) *DrivingResult {
+	routeFallbackStats.Add(1)
+	locations := append(append([]string{q.Origin}, q.Waypoints...), q.Destination)
+	points := make([][2]float64, 0, len(locations))
+	for _, l := range locations {
+		points = append(points, parsePoint(l))
+	}
+	var straight float64
+	for i := 1; i < len(points); i++ {
+		straight += haversine(points[i-1], points[i])
+	}
+	detour := re.detour
+	if d, ok := re.cityDetour[q.City]; ok && d >= 1 {
+		detour = d
+	}
+	departure := q.DepartureAt
+	if departure.IsZero() {
+		departure = time.Now()
+	}
+	distance := straight * detour
+	route := &Route{
+		Strategy: q.Strategy,
+		Distance: int64(math.Round(distance)),
+		Duration: int64(math.Round(distance / re.speeds[departure.In(re.loc).Hour()])),
+	}
+	if q.Polyline {
+		route.Polyline = EncodePolyline(points)
+	}
+	return &DrivingResult{Provider: RouteProviderFallback, Routes: []*Route{route}, Estimated: true}
+}
+
+// 解析已校验的 lng,lat
+func parsePoint(location string) [2]float64 {
+	parts := strings.Split(location, ",")
+	lng, _ := strconv.ParseFloat(parts[0], 64)
+	lat, _ := strconv.ParseFloat(parts[1], 64)
+	return [2]float64{lng, lat}
+}
+
+// 两点间的球面距离，单位 m，点为 [lng, lat]
+func haversine(a, b [2]float64) float64 {
+	const earthRadius = 6371000.0
+	lat1, lat2 := a[1]*math.Pi/180, b[1]*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b[0] - a[0]) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
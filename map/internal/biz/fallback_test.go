@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"map/internal/conf"
+)
+
+func TestHaversine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [2]float64
+		want float64 // 单位 m
+		tol  float64
+	}{
+		{"同一点", [2]float64{116.397128, 39.916527}, [2]float64{116.397128, 39.916527}, 0, 1e-6},
+		{"纬度相差 1 度", [2]float64{116, 39}, [2]float64{116, 40}, 111195, 1},
+		{"赤道上经度相差 1 度", [2]float64{0, 0}, [2]float64{1, 0}, 111195, 1},
+		{"北京到上海", [2]float64{116.397128, 39.916527}, [2]float64{121.473701, 31.230416}, 1067000, 2000},
+		{"跨越 180 度经线", [2]float64{179.5, 0}, [2]float64{-179.5, 0}, 111195, 1},
+	}
+	for _, tt := range tests {
+		if got := haversine(tt.a, tt.b); math.Abs(got-tt.want) > tt.tol {
+			t.Errorf("%s: haversine = %.1f, want %.1f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRouteEstimator(t *testing.T) {
+	if NewRouteEstimator(&conf.Map_Fallback{}) != nil {
+		t.Fatal("estimator should be nil when disabled")
+	}
+	hourly := make([]float64, 24)
+	hourly[8] = 18 // 早高峰
+	re := NewRouteEstimator(&conf.Map_Fallback{
+		Enabled:     true,
+		Detour:      1.5,
+		CityDetour:  map[string]float64{"310000": 1.2, "bad": 0.5},
+		Speed:       36,
+		HourlySpeed: hourly,
+		Timezone:    "Asia/Shanghai",
+	})
+	loc := time.FixedZone("CST", 8*3600)
+	// 纬度相差 0.1 度，直线距离约 11119.5m
+	origin, destination := "116.000000,39.000000", "116.000000,39.100000"
+	const straight = 11119.5
+	tests := []struct {
+		name         string
+		q            *RouteQuery
+		wantDistance float64
+		wantDuration float64
+	}{
+		{"默认绕行系数", &RouteQuery{Origin: origin, Destination: destination, DepartureAt: time.Date(2026, 3, 1, 14, 0, 0, 0, loc)}, straight * 1.5, straight * 1.5 / 10},
+		{"城市的绕行系数", &RouteQuery{Origin: origin, Destination: destination, City: "310000", DepartureAt: time.Date(2026, 3, 1, 14, 0, 0, 0, loc)}, straight * 1.2, straight * 1.2 / 10},
+		{"小于 1 的城市系数被忽略", &RouteQuery{Origin: origin, Destination: destination, City: "bad", DepartureAt: time.Date(2026, 3, 1, 14, 0, 0, 0, loc)}, straight * 1.5, straight * 1.5 / 10},
+		{"出发时刻的车速", &RouteQuery{Origin: origin, Destination: destination, DepartureAt: time.Date(2026, 3, 1, 8, 30, 0, 0, loc)}, straight * 1.5, straight * 1.5 / 5},
+		{"依次经过途经点", &RouteQuery{Origin: origin, Destination: origin, Waypoints: []string{destination}, DepartureAt: time.Date(2026, 3, 1, 14, 0, 0, 0, loc)}, straight * 2 * 1.5, straight * 2 * 1.5 / 10},
+	}
+	for _, tt := range tests {
+		result := re.Estimate(tt.q)
+		if !result.Estimated || result.Provider != RouteProviderFallback || len(result.Routes) != 1 {
+			t.Fatalf("%s: result %+v", tt.name, result)
+		}
+		route := result.Routes[0]
+		if math.Abs(float64(route.Distance)-tt.wantDistance) > 2 || math.Abs(float64(route.Duration)-tt.wantDuration) > 1 {
+			t.Errorf("%s: distance %d, duration %d, want %.0f, %.0f", tt.name, route.Distance, route.Duration, tt.wantDistance, tt.wantDuration)
+		}
+		if route.Polyline != "" {
+			t.Errorf("%s: polyline %q without being requested", tt.name, route.Polyline)
+		}
+	}
+	// 需要 polyline 时返回经过各点的直线
+	result := re.Estimate(&RouteQuery{Origin: origin, Destination: destination, Polyline: true})
+	points, ok := DecodePolyline(result.Routes[0].Polyline)
+	if !ok || len(points) != 2 || points[1] != [2]float64{116, 39.1} {
+		t.Fatalf("polyline points %v", points)
+	}
+}
+
+// 固定返回结果的服务商
+type fakeProvider struct {
+	name   string
+	routes []*Route
+	err    error
+	calls  int
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Driving(ctx context.Context, q *RouteQuery) ([]*Route, error) {
+	p.calls++
+	return p.routes, p.err
+}
+
+func TestDrivingFallback(t *testing.T) {
+	route := &Route{Distance: 1000, Duration: 100}
+	tests := []struct {
+		name      string
+		providers []*fakeProvider
+		enabled   bool
+		provider  string
+		estimated bool
+		wantErr   error
+	}{
+		{"首选服务商", []*fakeProvider{{name: "amap", routes: []*Route{route}}, {name: "baidu", routes: []*Route{route}}}, true, "amap", false, nil},
+		{"切换到下一个服务商", []*fakeProvider{{name: "amap", err: errors.New("timeout")}, {name: "baidu", routes: []*Route{route}}}, true, "baidu", false, nil},
+		{"全部失败时估算", []*fakeProvider{{name: "amap", err: errors.New("timeout")}, {name: "baidu", err: errors.New("quota")}}, true, RouteProviderFallback, true, nil},
+		{"未启用估算", []*fakeProvider{{name: "amap", err: errors.New("timeout")}}, false, "", false, ErrRouteUnavailable},
+		{"没有路线时不切换也不估算", []*fakeProvider{{name: "amap", err: ErrRouteNotFound}, {name: "baidu", routes: []*Route{route}}}, true, "", false, ErrRouteNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers := make([]RouteProvider, 0, len(tt.providers))
+			for _, p := range tt.providers {
+				providers = append(providers, p)
+			}
+			msbiz := NewMapServiceBiz(providers, nil, &conf.Map{Fallback: &conf.Map_Fallback{Enabled: tt.enabled}}, log.DefaultLogger)
+			result, err := msbiz.Driving(context.Background(), &RouteQuery{Origin: "116.397128,39.916527", Destination: "116.481028,39.989643"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				if errors.Is(err, ErrRouteNotFound) && tt.providers[1].calls != 0 {
+					t.Fatal("switched provider after route not found")
+				}
+				return
+			}
+			if err != nil || result.Provider != tt.provider || result.Estimated != tt.estimated {
+				t.Fatalf("result %+v, %v, want provider %s, estimated %t", result, err, tt.provider, tt.estimated)
+			}
+		})
+	}
+}
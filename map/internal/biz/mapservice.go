@@ -1,70 +1,275 @@
 package biz
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
+	"expvar"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"io"
-	"net/http"
+	"golang.org/x/sync/singleflight"
+	"map/internal/conf"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// 地图服务商
+const RouteProviderAMap = "amap"
+const RouteProviderBaidu = "baidu"
+const RouteProviderOSRM = "osrm"
+
+// 路线策略
+const RouteStrategyFastest = "fastest"              // 时间最短
+const RouteStrategyAvoidHighways = "avoid_highways" // 不走高速
+const RouteStrategyAvoidTolls = "avoid_tolls"       // 避免收费
+
+// 途经点的最大数量
+const RouteWaypointsMax = 16
+
+var (
+	ErrRouteParam       = errors.BadRequest("ROUTE_PARAM_ERROR", "origin, destination and waypoints must be lng,lat")
+	ErrRouteStrategy    = errors.BadRequest("ROUTE_PARAM_ERROR", "unknown route strategy")
+	ErrRouteWaypoints   = errors.BadRequest("ROUTE_PARAM_ERROR", "too many waypoints")
+	ErrRouteNotFound    = errors.NotFound("ROUTE_NOT_FOUND", "no route between origin and destination")
+	ErrRouteUnavailable = errors.ServiceUnavailable("LBS_ERROR", "all map providers failed")
+)
+
+// 坐标取整的默认小数位数，4 位约为 11m
+const RouteCachePrecisionDefault = 4
+
+// 路线缓存的统计，通过 HTTP 的 /debug/vars 查看
+// hit 命中，miss 未命中，shared 与相同的并发请求合并，error 读写缓存出错
+var routeCacheStats = expvar.NewMap("route_cache")
+
+// 路线规划的参数，坐标均为 lng,lat
+type RouteQuery struct {
+	Origin      string
+	Destination string
+	Strategy    string
+	Waypoints   []string
+	// 是否需要备选路线，为 false 时只保留第一条
+	Alternatives bool
+	// 是否需要 polyline
+	Polyline bool
+	// 城市编码和出发时间，只用于估算路线，不影响服务商的结果
+	City        string
+	DepartureAt time.Time
+}
+
+// 驾车路线
+type Route struct {
+	Strategy      string
+	Distance      int64 // 行驶距离，单位 m
+	Duration      int64 // 行驶时长，单位 second
+	Tolls         int64 // 过路费，单位分
+	TollDistance  int64 // 收费路段的距离，单位 m
+	TrafficLights int32
+	Polyline      string // Google encoded polyline
+}
+
+// 路线规划的结果，第一条为推荐路线
+type DrivingResult struct {
+	Provider string
+	Routes   []*Route
+	// 按直线距离估算，不缓存
+	Estimated bool
+}
+
+// 路线规划服务商，坐标均为 lng,lat（GCJ-02，与高德一致），各实现负责转换为服务商的格式
+// 服务商返回失败状态（例如高德的 status == "0"）时返回 error，没有路线时返回 ErrRouteNotFound
+// 服务商不提供的数据（例如 OSRM 的过路费）为 0
+type RouteProvider interface {
+	Name() string
+	Driving(ctx context.Context, q *RouteQuery) ([]*Route, error)
+}
+
+// 路线缓存相关的资源操作接口
+type RouteCache interface {
+	// 未命中时返回 nil
+	GetRoutes(ctx context.Context, key string) (*DrivingResult, error)
+	SaveRoutes(ctx context.Context, key string, result *DrivingResult, ttl time.Duration) error
+	// 批量查询，结果与 keys 一一对应，未命中的为 nil
+	MGetRoutes(ctx context.Context, keys []string) ([]*DrivingResult, error)
+	// 批量保存
+	MSaveRoutes(ctx context.Context, results map[string]*DrivingResult, ttl time.Duration) error
+}
+
 type MapServiceBiz struct {
 	log *log.Helper
+	// 按优先级排列的服务商，首选服务商失败时依次尝试
+	providers []RouteProvider
+	cache     RouteCache
+	cacheTTL  time.Duration
+	precision int
+	// 合并相同 key 的并发请求
+	sf singleflight.Group
+	// 所有服务商都不可用时估算路线，未启用时为 nil
+	estimator *RouteEstimator
+}
+
+func NewMapServiceBiz(providers []RouteProvider, cache RouteCache, mc *conf.Map, logger log.Logger) *MapServiceBiz {
+	precision := int(mc.GetCache().GetPrecision())
+	if precision <= 0 {
+		precision = RouteCachePrecisionDefault
+	}
+	return &MapServiceBiz{
+		providers: providers,
+		cache:     cache,
+		cacheTTL:  mc.GetCache().GetTtl().AsDuration(),
+		precision: precision,
+		estimator: NewRouteEstimator(mc.GetFallback()),
+		log:       log.NewHelper(logger),
+	}
 }
 
-func NewMapServiceBiz(logger log.Logger) *MapServiceBiz {
-	return &MapServiceBiz{log: log.NewHelper(logger)}
+// 驾车路线，坐标按 precision 取整，先查缓存，未命中时请求服务商并缓存结果
+// 相同参数的并发请求只请求一次服务商；所有服务商都不可用时，启用估算的情况下返回估算的路线
+func (msbiz *MapServiceBiz) Driving(ctx context.Context, q *RouteQuery) (*DrivingResult, error) {
+	q, err := msbiz.normalize(q)
+	if err != nil {
+		return nil, err
+	}
+	result, err := msbiz.cachedDriving(ctx, q)
+	if err != nil && msbiz.estimator != nil && errors.Is(err, ErrRouteUnavailable) {
+		msbiz.log.WithContext(ctx).Warnf("estimate route %s => %s", q.Origin, q.Destination)
+		return msbiz.estimator.Estimate(q), nil
+	}
+	return result, err
 }
 
-// 获取驾驶信息
-func (msbiz *MapServiceBiz) GetDriverInfo(origin, destination string) (string, string, error) {
-	// 一，请求获取
-	key := "2b08113dc921fac3afd0992a2b45862e"
-	api := "https://restapi.amap.com/v3/direction/driving"
-	parameters := fmt.Sprintf("origin=%s&destination=%s&extensions=base&output=json&key=%s", origin, destination, key)
-	url := api + "?" + parameters
-	resp, err := http.Get(url)
+// 查缓存，未命中时请求服务商
+func (msbiz *MapServiceBiz) cachedDriving(ctx context.Context, q *RouteQuery) (*DrivingResult, error) {
+	if msbiz.cacheTTL <= 0 {
+		return msbiz.driving(ctx, q)
+	}
+	key := routeQueryKey(q)
+	result, err := msbiz.cache.GetRoutes(ctx, key)
 	if err != nil {
-		return "", "", err
+		// 缓存不可用时直接请求服务商
+		routeCacheStats.Add("error", 1)
+		msbiz.log.WithContext(ctx).Warnf("get route cache %s: %v", key, err)
+	}
+	if result != nil && len(result.Routes) > 0 {
+		routeCacheStats.Add("hit", 1)
+		return result, nil
+	}
+	routeCacheStats.Add("miss", 1)
+	v, shared, err := shareCall(ctx, &msbiz.sf, key, func(ctx context.Context) (interface{}, error) {
+		result, err := msbiz.driving(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		if err := msbiz.cache.SaveRoutes(ctx, key, result, msbiz.cacheTTL); err != nil {
+			routeCacheStats.Add("error", 1)
+			msbiz.log.WithContext(ctx).Warnf("save route cache %s: %v", key, err)
+		}
+		return result, nil
+	})
+	if shared {
+		routeCacheStats.Add("shared", 1)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	body, err := io.ReadAll(resp.Body) // io.Reader
 	if err != nil {
-		return "", "", err
+		return nil, err
 	}
-	//fmt.Println(string(body))
-	// 二，解析出来,json
-	ddResp := &DirectionDrivingResp{}
-	if err := json.Unmarshal(body, ddResp); err != nil {
-		return "", "", err
+	return v.(*DrivingResult), nil
+}
+
+// 合并请求的超时，单个服务商的请求另有超时（map.timeout）
+const sharedCallTimeout = 5 * time.Second
+
+// 合并相同 key 的并发请求
+// 共享的请求在 context.WithoutCancel 上执行并使用自己的超时，不会因为发起请求的调用方取消或超时而让其他调用方失败；
+// 每个调用方只等待到自己的 ctx 结束
+func shareCall(ctx context.Context, sf *singleflight.Group, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, bool, error) {
+	ch := sf.DoChan(key, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedCallTimeout)
+		defer cancel()
+		return fn(ctx)
+	})
+	select {
+	case r := <-ch:
+		return r.Val, r.Shared, r.Err
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
 	}
+}
 
-	// 三，判定LSB请求结果
-	if ddResp.Status == "0" {
-		return "", "", errors.New(ddResp.Info)
+// 校验参数，坐标取整，策略为空时使用 fastest
+func (msbiz *MapServiceBiz) normalize(q *RouteQuery) (*RouteQuery, error) {
+	n := *q
+	var ok1, ok2 bool
+	n.Origin, ok1 = roundLocation(q.Origin, msbiz.precision)
+	n.Destination, ok2 = roundLocation(q.Destination, msbiz.precision)
+	if !ok1 || !ok2 {
+		return nil, ErrRouteParam
+	}
+	switch n.Strategy = strings.ToLower(strings.TrimSpace(q.Strategy)); n.Strategy {
+	case "":
+		n.Strategy = RouteStrategyFastest
+	case RouteStrategyFastest, RouteStrategyAvoidHighways, RouteStrategyAvoidTolls:
+	default:
+		return nil, ErrRouteStrategy
+	}
+	if len(q.Waypoints) > RouteWaypointsMax {
+		return nil, ErrRouteWaypoints
 	}
+	n.Waypoints = make([]string, 0, len(q.Waypoints))
+	for _, w := range q.Waypoints {
+		w, ok := roundLocation(w, msbiz.precision)
+		if !ok {
+			return nil, ErrRouteParam
+		}
+		n.Waypoints = append(n.Waypoints, w)
+	}
+	return &n, nil
+}
 
-	// 四，正确返回，默认使用第一条路线
-	path := ddResp.Route.Paths[0]
-	return path.Distance, path.Duration, nil
+// 缓存和合并请求的 key，包含影响结果的全部参数
+func routeQueryKey(q *RouteQuery) string {
+	return fmt.Sprintf("%s;%s|%s|%s|%t|%t", q.Origin, q.Destination, q.Strategy,
+		strings.Join(q.Waypoints, ";"), q.Alternatives, q.Polyline)
 }
 
-type DirectionDrivingResp struct {
-	Status   string `json:"status,omitempty"`
-	Info     string `json:"info,omitempty"`
-	Infocode string `json:"infocode,omitempty"`
-	Count    string `json:"count,omitempty"`
-	Route    struct {
-		Origin      string `json:"origin,omitempty"`
-		Destination string `json:"destination,omitempty"`
-		Paths       []Path `json:"paths,omitempty"`
-	} `json:"route"`
+// 按优先级请求服务商，出错时切换到下一个
+// 起终点之间没有路线时不再切换
+func (msbiz *MapServiceBiz) driving(ctx context.Context, q *RouteQuery) (*DrivingResult, error) {
+	for _, p := range msbiz.providers {
+		routes, err := p.Driving(ctx, q)
+		if err == nil && len(routes) == 0 {
+			err = ErrRouteNotFound
+		}
+		if err == nil {
+			if !q.Alternatives {
+				routes = routes[:1]
+			}
+			return &DrivingResult{Provider: p.Name(), Routes: routes}, nil
+		}
+		if errors.Is(err, ErrRouteNotFound) {
+			return nil, err
+		}
+		msbiz.log.WithContext(ctx).Warnf("map provider %s driving %s => %s: %v", p.Name(), q.Origin, q.Destination, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, ErrRouteUnavailable
 }
-type Path struct {
-	Distance string `json:"distance,omitempty"`
-	Duration string `json:"duration,omitempty"`
-	Strategy string `json:"strategy,omitempty"`
+
+// 校验 lng,lat 格式的坐标，并保留 precision 位小数
+func roundLocation(location string, precision int) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(location), ",")
+	if len(parts) != 2 {
+		return "", false
+	}
+	// ParseFloat 接受 NaN 和 Inf，NaN 与任何数比较都为 false，需要单独排除
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || math.IsNaN(lng) || math.IsInf(lng, 0) || lng < -180 || lng > 180 {
+		return "", false
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil || math.IsNaN(lat) || math.IsInf(lat, 0) || lat < -90 || lat > 90 {
+		return "", false
+	}
+	return strconv.FormatFloat(lng, 'f', precision, 64) + "," + strconv.FormatFloat(lat, 'f', precision, 64), true
 }
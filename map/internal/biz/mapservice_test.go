@@ -0,0 +1,85 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+func TestRoundLocation(t *testing.T) {
+	tests := []struct {
+		location  string
+		precision int
+		want      string
+		ok        bool
+	}{
+		{"116.4810281,39.9896434", 6, "116.481028,39.989643", true},
+		{" 116.48 , 39.98 ", 4, "116.4800,39.9800", true},
+		{"-180,-90", 2, "-180.00,-90.00", true},
+		{"180.1,39.9", 6, "", false},
+		{"116.4,90.1", 6, "", false},
+		{"116.4", 6, "", false},
+		{"abc,39.9", 6, "", false},
+		// NaN 会通过范围比较，Inf 超出范围，都需要拒绝
+		{"NaN,NaN", 6, "", false},
+		{"116.4,nan", 6, "", false},
+		{"Inf,39.9", 6, "", false},
+		{"116.4,-Inf", 6, "", false},
+		{"", 6, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := roundLocation(tt.location, tt.precision)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("roundLocation(%q, %d) = %q, %v, want %q, %v", tt.location, tt.precision, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestShareCall(t *testing.T) {
+	var sf singleflight.Group
+	started, release := make(chan struct{}), make(chan struct{})
+	fn := func(ctx context.Context) (interface{}, error) {
+		close(started)
+		select {
+		case <-release:
+			return "route", nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	// 发起请求的调用方
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, _, err := shareCall(firstCtx, &sf, "key", fn)
+		firstErr <- err
+	}()
+	<-started
+	// 共享同一请求的调用方
+	second := make(chan string, 1)
+	go func() {
+		v, shared, err := shareCall(context.Background(), &sf, "key", func(ctx context.Context) (interface{}, error) {
+			t.Error("second caller should share the first call")
+			return nil, nil
+		})
+		if err != nil || !shared {
+			t.Errorf("second caller = %v, %v, %v", v, shared, err)
+			second <- ""
+			return
+		}
+		second <- v.(string)
+	}()
+	// 等待第二个调用方加入后取消第一个
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first caller error = %v, want context.Canceled", err)
+	}
+	close(release)
+	if v := <-second; v != "route" {
+		t.Fatalf("second caller = %q, want route", v)
+	}
+}
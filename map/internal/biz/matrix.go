@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sync"
+)
+
+// 距离矩阵的最大单元格数
+const MatrixCellsMax = 100
+
+// 服务商不提供矩阵时，逐个请求路线的并发数
+const MatrixConcurrency = 8
+
+var ErrMatrixTooLarge = errors.BadRequest("ROUTE_PARAM_ERROR", "too many matrix cells")
+
+// 距离矩阵的单元格，Err 不为 nil 时距离和时长无效
+type MatrixCell struct {
+	Distance int64 // 单位 m
+	Duration int64 // 单位 second
+	Err      error
+	// 按直线距离估算
+	Estimated bool
+}
+
+// 提供距离矩阵接口的服务商，可选，由 RouteProvider 的实现同时实现
+// 返回 len(origins) 行、len(destinations) 列，没有路线的单元格 Err 为 ErrRouteNotFound，
+// 服务商未返回的单元格为 nil，之后逐个请求
+type MatrixProvider interface {
+	Name() string
+	Matrix(ctx context.Context, origins, destinations []string) ([][]*MatrixCell, error)
+}
+
+// 距离矩阵，使用 fastest 策略，每个单元格单独返回错误
+// 一，坐标取整后查路线缓存；二，未命中的单元格按优先级请求服务商的矩阵接口，整体失败时切换到下一个；
+// 三，仍然缺失的单元格逐个请求路线（并发数为 MatrixConcurrency），与 GetDrivingInfo 共用缓存和故障切换
+func (msbiz *MapServiceBiz) DistanceMatrix(ctx context.Context, origins, destinations []string, city string) ([][]*MatrixCell, error) {
+	if len(origins) == 0 || len(destinations) == 0 {
+		return nil, ErrRouteParam
+	}
+	if len(origins)*len(destinations) > MatrixCellsMax {
+		return nil, ErrMatrixTooLarge
+	}
+	cells := make([][]*MatrixCell, len(origins))
+	for i := range cells {
+		cells[i] = make([]*MatrixCell, len(destinations))
+	}
+	// 坐标错误的行和列
+	origins, destinations = msbiz.roundMatrix(cells, origins, destinations)
+	msbiz.matrixFromCache(ctx, cells, origins, destinations)
+	if failed := msbiz.matrixFromProviders(ctx, cells, origins, destinations); failed && msbiz.estimator != nil {
+		// 矩阵接口都不可用时，逐个请求大概率也会失败，直接估算，避免超时
+		msbiz.matrixEstimate(cells, origins, destinations, city)
+	}
+	// 逐个请求剩余的单元格
+	sem := make(chan struct{}, MatrixConcurrency)
+	wg := sync.WaitGroup{}
+	for i := range cells {
+		for j := range cells[i] {
+			if cells[i][j] != nil {
+				continue
+			}
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i, j int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				result, err := msbiz.Driving(ctx, &RouteQuery{Origin: origins[i], Destination: destinations[j], City: city})
+				if err != nil {
+					cells[i][j] = &MatrixCell{Err: err}
+					return
+				}
+				cells[i][j] = &MatrixCell{
+					Distance:  result.Routes[0].Distance,
+					Duration:  result.Routes[0].Duration,
+					Estimated: result.Estimated,
+				}
+			}(i, j)
+		}
+	}
+	wg.Wait()
+	return cells, nil
+}
+
+// 坐标取整，格式错误的起点所在行、终点所在列标记为 ErrRouteParam
+func (msbiz *MapServiceBiz) roundMatrix(cells [][]*MatrixCell, origins, destinations []string) ([]string, []string) {
+	ro := make([]string, len(origins))
+	rd := make([]string, len(destinations))
+	for i, o := range origins {
+		var ok bool
+		if ro[i], ok = roundLocation(o, msbiz.precision); !ok {
+			for j := range cells[i] {
+				cells[i][j] = &MatrixCell{Err: ErrRouteParam}
+			}
+		}
+	}
+	for j, d := range destinations {
+		var ok bool
+		if rd[j], ok = roundLocation(d, msbiz.precision); !ok {
+			for i := range cells {
+				cells[i][j] = &MatrixCell{Err: ErrRouteParam}
+			}
+		}
+	}
+	return ro, rd
+}
+
+// 路线缓存和矩阵结果的 key，矩阵结果没有过路费等数据，单独缓存，不影响 GetDrivingInfo
+func matrixKeys(origin, destination string) (routeKey, matrixKey string) {
+	routeKey = routeQueryKey(&RouteQuery{Origin: origin, Destination: destination, Strategy: RouteStrategyFastest})
+	return routeKey, routeKey + "|matrix"
+}
+
+// 查路线缓存，GetDrivingInfo 和矩阵的结果都可以使用
+func (msbiz *MapServiceBiz) matrixFromCache(ctx context.Context, cells [][]*MatrixCell, origins, destinations []string) {
+	if msbiz.cacheTTL <= 0 {
+		return
+	}
+	type pos struct{ i, j int }
+	keys := make([]string, 0, 2*len(origins)*len(destinations))
+	positions := make([]pos, 0, len(origins)*len(destinations))
+	for i := range cells {
+		for j := range cells[i] {
+			if cells[i][j] != nil {
+				continue
+			}
+			routeKey, matrixKey := matrixKeys(origins[i], destinations[j])
+			keys = append(keys, routeKey, matrixKey)
+			positions = append(positions, pos{i, j})
+		}
+	}
+	if len(positions) == 0 {
+		return
+	}
+	results, err := msbiz.cache.MGetRoutes(ctx, keys)
+	if err != nil {
+		routeCacheStats.Add("error", 1)
+		msbiz.log.WithContext(ctx).Warnf("get matrix cache: %v", err)
+		return
+	}
+	for k, p := range positions {
+		for _, result := range results[2*k : 2*k+2] {
+			if result != nil && len(result.Routes) > 0 {
+				cells[p.i][p.j] = &MatrixCell{Distance: result.Routes[0].Distance, Duration: result.Routes[0].Duration}
+				break
+			}
+		}
+		if cells[p.i][p.j] != nil {
+			routeCacheStats.Add("hit", 1)
+		} else {
+			routeCacheStats.Add("miss", 1)
+		}
+	}
+}
+
+// 估算缺失的单元格
+func (msbiz *MapServiceBiz) matrixEstimate(cells [][]*MatrixCell, origins, destinations []string, city string) {
+	for i := range cells {
+		for j := range cells[i] {
+			if cells[i][j] != nil {
+				continue
+			}
+			result := msbiz.estimator.Estimate(&RouteQuery{Origin: origins[i], Destination: destinations[j], City: city})
+			cells[i][j] = &MatrixCell{Distance: result.Routes[0].Distance, Duration: result.Routes[0].Duration, Estimated: true}
+		}
+	}
+}
+
+// 按优先级请求服务商的矩阵接口，只请求缺失单元格所在的起点和终点，成功后缓存
+// 提供矩阵接口的服务商都失败时返回 true
+func (msbiz *MapServiceBiz) matrixFromProviders(ctx context.Context, cells [][]*MatrixCell, origins, destinations []string) bool {
+	var oi, di []int
+	usedO, usedD := map[int]bool{}, map[int]bool{}
+	for i := range cells {
+		for j := range cells[i] {
+			if cells[i][j] != nil {
+				continue
+			}
+			if !usedO[i] {
+				usedO[i] = true
+				oi = append(oi, i)
+			}
+			if !usedD[j] {
+				usedD[j] = true
+				di = append(di, j)
+			}
+		}
+	}
+	if len(oi) == 0 {
+		return false
+	}
+	subOrigins := make([]string, 0, len(oi))
+	for _, i := range oi {
+		subOrigins = append(subOrigins, origins[i])
+	}
+	subDestinations := make([]string, 0, len(di))
+	for _, j := range di {
+		subDestinations = append(subDestinations, destinations[j])
+	}
+	tried := false
+	for _, p := range msbiz.providers {
+		mp, ok := p.(MatrixProvider)
+		if !ok {
+			continue
+		}
+		tried = true
+		sub, err := mp.Matrix(ctx, subOrigins, subDestinations)
+		if err != nil {
+			msbiz.log.WithContext(ctx).Warnf("map provider %s matrix %dx%d: %v", mp.Name(), len(subOrigins), len(subDestinations), err)
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		saved := map[string]*DrivingResult{}
+		for a, i := range oi {
+			for b, j := range di {
+				if cells[i][j] != nil || a >= len(sub) || b >= len(sub[a]) || sub[a][b] == nil {
+					continue
+				}
+				cell := sub[a][b]
+				cells[i][j] = cell
+				if cell.Err == nil {
+					_, matrixKey := matrixKeys(origins[i], destinations[j])
+					saved[matrixKey] = &DrivingResult{Provider: mp.Name(), Routes: []*Route{{
+						Strategy: RouteStrategyFastest,
+						Distance: cell.Distance,
+						Duration: cell.Duration,
+					}}}
+				}
+			}
+		}
+		if msbiz.cacheTTL > 0 && len(saved) > 0 {
+			if err := msbiz.cache.MSaveRoutes(ctx, saved, msbiz.cacheTTL); err != nil {
+				routeCacheStats.Add("error", 1)
+				msbiz.log.WithContext(ctx).Warnf("save matrix cache: %v", err)
+			}
+		}
+		return false
+	}
+	return tried
+}
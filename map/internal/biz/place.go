@@ -0,0 +1,195 @@
+package biz
+
+import (
+	"context"
+	"expvar"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/sync/singleflight"
+	"map/internal/conf"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 地址和关键字的最大长度（字符数）
+const PlaceTextMax = 100
+
+var (
+	ErrPlaceParam       = errors.BadRequest("PLACE_PARAM_ERROR", "place param error")
+	ErrPlaceNotFound    = errors.NotFound("PLACE_NOT_FOUND", "no place matches")
+	ErrPlaceUnavailable = errors.ServiceUnavailable("LBS_ERROR", "all map providers failed")
+)
+
+// 地点缓存的统计，含义与 route_cache 相同
+var placeCacheStats = expvar.NewMap("place_cache")
+
+// 地点，坐标为 lng,lat（GCJ-02）
+type Place struct {
+	Name     string
+	Address  string
+	Location string
+	Province string
+	City     string
+	District string
+	Adcode   string
+}
+
+// 地点服务商，与路线规划使用相同的服务商和优先级，OSRM 不提供地点服务
+// 没有结果时 Geocode 和 ReverseGeocode 返回 ErrPlaceNotFound，PlaceSuggest 返回空列表
+type PlaceProvider interface {
+	Name() string
+	Geocode(ctx context.Context, address, city string) ([]*Place, error)
+	ReverseGeocode(ctx context.Context, location string) (*Place, error)
+	PlaceSuggest(ctx context.Context, keyword, city, near string) ([]*Place, error)
+}
+
+// 地点缓存相关的资源操作接口
+type PlaceCache interface {
+	// 未命中时返回 nil，空列表也是有效的结果
+	GetPlaces(ctx context.Context, key string) (*PlaceResult, error)
+	SavePlaces(ctx context.Context, key string, result *PlaceResult, ttl time.Duration) error
+}
+
+// 地点查询的结果
+type PlaceResult struct {
+	Provider string
+	Places   []*Place
+}
+
+type PlaceBiz struct {
+	log       *log.Helper
+	providers []PlaceProvider
+	cache     PlaceCache
+	cacheTTL  time.Duration
+	precision int
+	sf        singleflight.Group
+}
+
+func NewPlaceBiz(providers []PlaceProvider, cache PlaceCache, mc *conf.Map, logger log.Logger) *PlaceBiz {
+	precision := int(mc.GetCache().GetPrecision())
+	if precision <= 0 {
+		precision = RouteCachePrecisionDefault
+	}
+	return &PlaceBiz{
+		providers: providers,
+		cache:     cache,
+		cacheTTL:  mc.GetCache().GetPlaceTtl().AsDuration(),
+		precision: precision,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// 地理编码
+func (pb *PlaceBiz) Geocode(ctx context.Context, address, city string) (*PlaceResult, error) {
+	address, city = strings.TrimSpace(address), strings.TrimSpace(city)
+	if !placeText(address, true) || !placeText(city, false) {
+		return nil, ErrPlaceParam
+	}
+	return pb.query(ctx, "geo|"+city+"|"+address, func(ctx context.Context, p PlaceProvider) ([]*Place, error) {
+		return p.Geocode(ctx, address, city)
+	})
+}
+
+// 逆地理编码，坐标按 precision 取整
+func (pb *PlaceBiz) ReverseGeocode(ctx context.Context, lng, lat float64) (*Place, string, error) {
+	location, ok := roundLocation(strconv.FormatFloat(lng, 'f', -1, 64)+","+strconv.FormatFloat(lat, 'f', -1, 64), pb.precision)
+	if !ok {
+		return nil, "", ErrPlaceParam
+	}
+	result, err := pb.query(ctx, "regeo|"+location, func(ctx context.Context, p PlaceProvider) ([]*Place, error) {
+		place, err := p.ReverseGeocode(ctx, location)
+		if err != nil {
+			return nil, err
+		}
+		return []*Place{place}, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return result.Places[0], result.Provider, nil
+}
+
+// 输入提示，near 按 precision 取整
+func (pb *PlaceBiz) PlaceSuggest(ctx context.Context, keyword, city, near string) (*PlaceResult, error) {
+	keyword, city = strings.TrimSpace(keyword), strings.TrimSpace(city)
+	if !placeText(keyword, true) || !placeText(city, false) {
+		return nil, ErrPlaceParam
+	}
+	if near != "" {
+		var ok bool
+		if near, ok = roundLocation(near, pb.precision); !ok {
+			return nil, ErrPlaceParam
+		}
+	}
+	return pb.query(ctx, "tips|"+city+"|"+near+"|"+keyword, func(ctx context.Context, p PlaceProvider) ([]*Place, error) {
+		return p.PlaceSuggest(ctx, keyword, city, near)
+	})
+}
+
+// 先查缓存，未命中时按优先级请求服务商并缓存结果，相同 key 的并发请求只请求一次
+// 没有结果时不再切换服务商
+func (pb *PlaceBiz) query(ctx context.Context, key string, fn func(ctx context.Context, p PlaceProvider) ([]*Place, error)) (*PlaceResult, error) {
+	if pb.cacheTTL > 0 {
+		result, err := pb.cache.GetPlaces(ctx, key)
+		if err != nil {
+			placeCacheStats.Add("error", 1)
+			pb.log.WithContext(ctx).Warnf("get place cache %s: %v", key, err)
+		}
+		if result != nil {
+			placeCacheStats.Add("hit", 1)
+			return result, nil
+		}
+		placeCacheStats.Add("miss", 1)
+	}
+	v, shared, err := shareCall(ctx, &pb.sf, key, func(ctx context.Context) (interface{}, error) {
+		result, err := pb.request(ctx, key, fn)
+		if err != nil {
+			return nil, err
+		}
+		if pb.cacheTTL > 0 {
+			if err := pb.cache.SavePlaces(ctx, key, result, pb.cacheTTL); err != nil {
+				placeCacheStats.Add("error", 1)
+				pb.log.WithContext(ctx).Warnf("save place cache %s: %v", key, err)
+			}
+		}
+		return result, nil
+	})
+	if shared {
+		placeCacheStats.Add("shared", 1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v.(*PlaceResult), nil
+}
+
+// 按优先级请求服务商，出错时切换到下一个
+func (pb *PlaceBiz) request(ctx context.Context, key string, fn func(ctx context.Context, p PlaceProvider) ([]*Place, error)) (*PlaceResult, error) {
+	for _, p := range pb.providers {
+		places, err := fn(ctx, p)
+		if err == nil {
+			if places == nil {
+				places = []*Place{}
+			}
+			return &PlaceResult{Provider: p.Name(), Places: places}, nil
+		}
+		if errors.Is(err, ErrPlaceNotFound) {
+			return nil, err
+		}
+		pb.log.WithContext(ctx).Warnf("map provider %s place %s: %v", p.Name(), key, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, ErrPlaceUnavailable
+}
+
+// 地址、关键字和城市，不能包含 | 以免与缓存 key 的分隔符混淆
+func placeText(s string, required bool) bool {
+	if s == "" {
+		return !required
+	}
+	return utf8.RuneCountInString(s) <= PlaceTextMax && !strings.ContainsAny(s, "|\r\n")
+}
@@ -0,0 +1,74 @@
+package biz
+
+import "math"
+
+// 按 Google encoded polyline 算法编码坐标，points 为 [lng, lat]，编码时为 lat,lng 顺序，精度 1e-5
+// 与上一个点相同的点被跳过
+func EncodePolyline(points [][2]float64) string {
+	buf := make([]byte, 0, len(points)*8)
+	var prevLat, prevLng int64
+	for i, p := range points {
+		lat := int64(math.Round(p[1] * 1e5))
+		lng := int64(math.Round(p[0] * 1e5))
+		if i > 0 && lat == prevLat && lng == prevLng {
+			continue
+		}
+		buf = appendPolylineValue(buf, lat-prevLat)
+		buf = appendPolylineValue(buf, lng-prevLng)
+		prevLat, prevLng = lat, lng
+	}
+	return string(buf)
+}
+
+// 编码一个差值，左移一位，负数取反，每 5 位一组加 63
+func appendPolylineValue(buf []byte, v int64) []byte {
+	u := uint64(v) << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		buf = append(buf, byte(0x20|u&0x1f)+63)
+		u >>= 5
+	}
+	return append(buf, byte(u)+63)
+}
+
+// 解码 Google encoded polyline，返回 [lng, lat]，格式错误时返回 false
+func DecodePolyline(s string) ([][2]float64, bool) {
+	points := [][2]float64{}
+	var lat, lng int64
+	for i := 0; i < len(s); {
+		var dLat, dLng int64
+		var ok bool
+		if dLat, i, ok = readPolylineValue(s, i); !ok {
+			return nil, false
+		}
+		if dLng, i, ok = readPolylineValue(s, i); !ok {
+			return nil, false
+		}
+		lat, lng = lat+dLat, lng+dLng
+		points = append(points, [2]float64{float64(lng) / 1e5, float64(lat) / 1e5})
+	}
+	return points, true
+}
+
+// 从 i 开始读取一个差值，返回差值和下一个值的位置
+func readPolylineValue(s string, i int) (int64, int, bool) {
+	var u uint64
+	for shift := uint(0); i < len(s) && shift < 64; shift += 5 {
+		b := uint64(s[i]) - 63
+		i++
+		if b > 0x3f {
+			return 0, i, false
+		}
+		u |= (b & 0x1f) << shift
+		if b < 0x20 {
+			v := int64(u >> 1)
+			if u&1 != 0 {
+				v = ^v
+			}
+			return v, i, true
+		}
+	}
+	return 0, i, false
+}
@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEncodePolyline(t *testing.T) {
+	tests := []struct {
+		name   string
+		points [][2]float64
+		want   string
+	}{
+		// Google 文档中的示例
+		{"示例", [][2]float64{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}, "_p~iF~ps|U_ulLnnqC_mqNvxq`@"},
+		{"跳过重复的点", [][2]float64{{-120.2, 38.5}, {-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}, "_p~iF~ps|U_ulLnnqC_mqNvxq`@"},
+		{"原点", [][2]float64{{0, 0}}, "??"},
+		{"空", nil, ""},
+	}
+	for _, tt := range tests {
+		if got := EncodePolyline(tt.points); got != tt.want {
+			t.Errorf("%s: EncodePolyline = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDecodePolyline(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		want    [][2]float64
+		ok      bool
+	}{
+		{"示例", "_p~iF~ps|U_ulLnnqC_mqNvxq`@", [][2]float64{{-120.2, 38.5}, {-120.95, 40.7}, {-126.453, 43.252}}, true},
+		{"空", "", [][2]float64{}, true},
+		{"缺少经度", "_p~iF", nil, false},
+		{"截断", "_p~iF~ps|", nil, false},
+		{"非法字符", "_p~iF~ps| U", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := DecodePolyline(tt.encoded)
+		if ok != tt.ok || len(got) != len(tt.want) {
+			t.Errorf("%s: DecodePolyline = %v, %t, want %v, %t", tt.name, got, ok, tt.want, tt.ok)
+			continue
+		}
+		for i := range got {
+			if math.Abs(got[i][0]-tt.want[i][0]) > 1e-9 || math.Abs(got[i][1]-tt.want[i][1]) > 1e-9 {
+				t.Errorf("%s: point %d = %v, want %v", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestPolylineRoundTrip(t *testing.T) {
+	points := [][2]float64{{116.39713, 39.91653}, {116.40112, 39.91402}, {116.48103, 39.98964}, {121.4737, 31.23042}}
+	got, ok := DecodePolyline(EncodePolyline(points))
+	if !ok || len(got) != len(points) {
+		t.Fatalf("DecodePolyline = %v, %t", got, ok)
+	}
+	for i := range points {
+		if math.Abs(got[i][0]-points[i][0]) > 1e-9 || math.Abs(got[i][1]-points[i][1]) > 1e-9 {
+			t.Fatalf("point %d = %v, want %v", i, got[i], points[i])
+		}
+	}
+}
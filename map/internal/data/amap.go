@@ -0,0 +1,333 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"map/internal/biz"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// 高德地图 Web 服务
+type AMapProvider struct {
+	client *http.Client
+	key    string
+}
+
+// 路线策略对应的高德 strategy，10~20 的策略会返回多条路线
+var amapStrategies = map[string]string{
+	biz.RouteStrategyFastest:       "0",  // 速度优先，时间最短
+	biz.RouteStrategyAvoidHighways: "13", // 不走高速
+	biz.RouteStrategyAvoidTolls:    "14", // 避免收费
+}
+
+func (p *AMapProvider) Name() string {
+	return biz.RouteProviderAMap
+}
+
+// 驾车路径规划 v3/direction/driving，第一条为推荐路线
+func (p *AMapProvider) Driving(ctx context.Context, q *biz.RouteQuery) ([]*biz.Route, error) {
+	params := url.Values{}
+	params.Set("origin", q.Origin)
+	params.Set("destination", q.Destination)
+	params.Set("strategy", amapStrategies[q.Strategy])
+	if len(q.Waypoints) > 0 {
+		params.Set("waypoints", strings.Join(q.Waypoints, ";"))
+	}
+	params.Set("extensions", "base")
+	params.Set("output", "json")
+	params.Set("key", p.key)
+	ddResp := &DirectionDrivingResp{}
+	if err := getJSON(ctx, p.client, "https://restapi.amap.com/v3/direction/driving?"+params.Encode(), ddResp); err != nil {
+		return nil, err
+	}
+	// 判定LBS请求结果
+	if ddResp.Status == "0" {
+		return nil, errors.New(ddResp.Info)
+	}
+	if len(ddResp.Route.Paths) == 0 {
+		return nil, biz.ErrRouteNotFound
+	}
+	routes := make([]*biz.Route, 0, len(ddResp.Route.Paths))
+	for _, path := range ddResp.Route.Paths {
+		route, err := path.toRoute(q)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+type DirectionDrivingResp struct {
+	Status   string `json:"status,omitempty"`
+	Info     string `json:"info,omitempty"`
+	Infocode string `json:"infocode,omitempty"`
+	Count    string `json:"count,omitempty"`
+	Route    struct {
+		Origin      string `json:"origin,omitempty"`
+		Destination string `json:"destination,omitempty"`
+		Paths       []Path `json:"paths,omitempty"`
+	} `json:"route"`
+}
+
+// 高德返回的数值均为字符串，过路费单位为元
+type Path struct {
+	Distance      string `json:"distance,omitempty"`
+	Duration      string `json:"duration,omitempty"`
+	Strategy      string `json:"strategy,omitempty"`
+	Tolls         string `json:"tolls,omitempty"`
+	TollDistance  string `json:"toll_distance,omitempty"`
+	TrafficLights string `json:"traffic_lights,omitempty"`
+	Steps         []struct {
+		Polyline string `json:"polyline,omitempty"`
+	} `json:"steps,omitempty"`
+}
+
+func (path *Path) toRoute(q *biz.RouteQuery) (*biz.Route, error) {
+	var err error
+	route := &biz.Route{Strategy: q.Strategy}
+	if route.Distance, err = strconv.ParseInt(path.Distance, 10, 64); err != nil {
+		return nil, err
+	}
+	if route.Duration, err = strconv.ParseInt(path.Duration, 10, 64); err != nil {
+		return nil, err
+	}
+	if route.Tolls, err = yuanToCents(path.Tolls); err != nil {
+		return nil, err
+	}
+	// 以下字段只用于展示，解析失败时为 0
+	route.TollDistance, _ = strconv.ParseInt(path.TollDistance, 10, 64)
+	lights, _ := strconv.ParseInt(path.TrafficLights, 10, 32)
+	route.TrafficLights = int32(lights)
+	if q.Polyline {
+		var points [][2]float64
+		for _, step := range path.Steps {
+			points = appendPathPoints(points, step.Polyline)
+		}
+		route.Polyline = biz.EncodePolyline(points)
+	}
+	return route, nil
+}
+
+// 高德返回的字符串字段为空时是 []，解析为空字符串
+type amapString string
+
+func (s *amapString) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '[' {
+		*s = ""
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*s = amapString(v)
+	return nil
+}
+
+// 地理编码 v3/geocode/geo
+func (p *AMapProvider) Geocode(ctx context.Context, address, city string) ([]*biz.Place, error) {
+	params := url.Values{}
+	params.Set("address", address)
+	if city != "" {
+		params.Set("city", city)
+	}
+	params.Set("output", "json")
+	params.Set("key", p.key)
+	resp := &AMapGeocodeResp{}
+	if err := getJSON(ctx, p.client, "https://restapi.amap.com/v3/geocode/geo?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status == "0" {
+		return nil, errors.New(resp.Info)
+	}
+	places := make([]*biz.Place, 0, len(resp.Geocodes))
+	for _, g := range resp.Geocodes {
+		if g.Location == "" {
+			continue
+		}
+		places = append(places, &biz.Place{
+			Name:     string(g.FormattedAddress),
+			Address:  string(g.FormattedAddress),
+			Location: string(g.Location),
+			Province: string(g.Province),
+			City:     amapCity(g.Province, g.City),
+			District: string(g.District),
+			Adcode:   string(g.Adcode),
+		})
+	}
+	if len(places) == 0 {
+		return nil, biz.ErrPlaceNotFound
+	}
+	return places, nil
+}
+
+// 逆地理编码 v3/geocode/regeo
+func (p *AMapProvider) ReverseGeocode(ctx context.Context, location string) (*biz.Place, error) {
+	params := url.Values{}
+	params.Set("location", location)
+	params.Set("output", "json")
+	params.Set("key", p.key)
+	resp := &AMapRegeoResp{}
+	if err := getJSON(ctx, p.client, "https://restapi.amap.com/v3/geocode/regeo?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status == "0" {
+		return nil, errors.New(resp.Info)
+	}
+	r := resp.Regeocode
+	// 海域等没有地址的位置
+	if r.FormattedAddress == "" {
+		return nil, biz.ErrPlaceNotFound
+	}
+	c := r.AddressComponent
+	return &biz.Place{
+		Name:     string(r.FormattedAddress),
+		Address:  string(r.FormattedAddress),
+		Location: location,
+		Province: string(c.Province),
+		City:     amapCity(c.Province, c.City),
+		District: string(c.District),
+		Adcode:   string(c.Adcode),
+	}, nil
+}
+
+// 输入提示 v3/assistant/inputtips，没有坐标的结果（例如公交线路）被跳过
+func (p *AMapProvider) PlaceSuggest(ctx context.Context, keyword, city, near string) ([]*biz.Place, error) {
+	params := url.Values{}
+	params.Set("keywords", keyword)
+	if city != "" {
+		params.Set("city", city)
+	}
+	if near != "" {
+		params.Set("location", near)
+	}
+	params.Set("output", "json")
+	params.Set("key", p.key)
+	resp := &AMapInputTipsResp{}
+	if err := getJSON(ctx, p.client, "https://restapi.amap.com/v3/assistant/inputtips?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status == "0" {
+		return nil, errors.New(resp.Info)
+	}
+	places := make([]*biz.Place, 0, len(resp.Tips))
+	for _, t := range resp.Tips {
+		if t.Location == "" {
+			continue
+		}
+		places = append(places, &biz.Place{
+			Name:     string(t.Name),
+			Address:  string(t.District) + string(t.Address),
+			Location: string(t.Location),
+			District: string(t.District),
+			Adcode:   string(t.Adcode),
+		})
+	}
+	return places, nil
+}
+
+// 直辖市的 city 为空，使用 province
+func amapCity(province, city amapString) string {
+	if city == "" {
+		return string(province)
+	}
+	return string(city)
+}
+
+type AMapGeocodeResp struct {
+	Status   string `json:"status"`
+	Info     string `json:"info"`
+	Geocodes []struct {
+		FormattedAddress amapString `json:"formatted_address"`
+		Province         amapString `json:"province"`
+		City             amapString `json:"city"`
+		District         amapString `json:"district"`
+		Adcode           amapString `json:"adcode"`
+		Location         amapString `json:"location"`
+	} `json:"geocodes"`
+}
+
+type AMapRegeoResp struct {
+	Status    string `json:"status"`
+	Info      string `json:"info"`
+	Regeocode struct {
+		FormattedAddress amapString `json:"formatted_address"`
+		AddressComponent struct {
+			Province amapString `json:"province"`
+			City     amapString `json:"city"`
+			District amapString `json:"district"`
+			Adcode   amapString `json:"adcode"`
+		} `json:"addressComponent"`
+	} `json:"regeocode"`
+}
+
+type AMapInputTipsResp struct {
+	Status string `json:"status"`
+	Info   string `json:"info"`
+	Tips   []struct {
+		Name     amapString `json:"name"`
+		District amapString `json:"district"`
+		Adcode   amapString `json:"adcode"`
+		Location amapString `json:"location"`
+		Address  amapString `json:"address"`
+	} `json:"tips"`
+}
+
+// 距离测量 v3/distance，多个起点到一个终点，每个终点请求一次
+func (p *AMapProvider) Matrix(ctx context.Context, origins, destinations []string) ([][]*biz.MatrixCell, error) {
+	cells := newMatrix(len(origins), len(destinations))
+	for j, destination := range destinations {
+		// 起点最多 100 个
+		for _, c := range chunks(len(origins), 100) {
+			params := url.Values{}
+			params.Set("origins", strings.Join(origins[c[0]:c[1]], "|"))
+			params.Set("destination", destination)
+			params.Set("type", "1") // 驾车导航距离
+			params.Set("output", "json")
+			params.Set("key", p.key)
+			resp := &AMapDistanceResp{}
+			if err := getJSON(ctx, p.client, "https://restapi.amap.com/v3/distance?"+params.Encode(), resp); err != nil {
+				return nil, err
+			}
+			if resp.Status == "0" {
+				return nil, errors.New(resp.Info)
+			}
+			for _, r := range resp.Results {
+				// origin_id 从 1 开始
+				id, err := strconv.Atoi(string(r.OriginID))
+				if err != nil || id < 1 || c[0]+id > c[1] {
+					continue
+				}
+				i := c[0] + id - 1
+				// code 不为空时表示未规划出路线
+				if r.Code != "" && r.Code != "0" {
+					cells[i][j] = &biz.MatrixCell{Err: biz.ErrRouteNotFound}
+					continue
+				}
+				distance, err1 := strconv.ParseInt(string(r.Distance), 10, 64)
+				duration, err2 := strconv.ParseInt(string(r.Duration), 10, 64)
+				if err1 != nil || err2 != nil {
+					continue
+				}
+				cells[i][j] = &biz.MatrixCell{Distance: distance, Duration: duration}
+			}
+		}
+	}
+	return cells, nil
+}
+
+type AMapDistanceResp struct {
+	Status  string `json:"status"`
+	Info    string `json:"info"`
+	Results []struct {
+		OriginID amapString `json:"origin_id"`
+		Distance amapString `json:"distance"`
+		Duration amapString `json:"duration"`
+		Code     amapString `json:"code"`
+	} `json:"results"`
+}
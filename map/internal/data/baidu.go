@@ -0,0 +1,292 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"map/internal/biz"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// 百度地图开放平台
+type BaiduProvider struct {
+	client *http.Client
+	ak     string
+}
+
+// 路线策略对应的百度 tactics
+var baiduTactics = map[string]string{
+	biz.RouteStrategyFastest:       "0", // 常规路线
+	biz.RouteStrategyAvoidHighways: "3", // 不走高速
+	biz.RouteStrategyAvoidTolls:    "6", // 少收费
+}
+
+func (p *BaiduProvider) Name() string {
+	return biz.RouteProviderBaidu
+}
+
+// 驾车路线规划 direction/v2/driving，轻量级接口不支持路线偏好，因此使用 v2
+// 百度的坐标为 lat,lng 顺序，coord_type=gcj02 表示传入的是高德（国测局）坐标，ret_coordtype=gcj02 返回同样的坐标系
+func (p *BaiduProvider) Driving(ctx context.Context, q *biz.RouteQuery) ([]*biz.Route, error) {
+	params := url.Values{}
+	params.Set("origin", baiduLocation(q.Origin))
+	params.Set("destination", baiduLocation(q.Destination))
+	if len(q.Waypoints) > 0 {
+		waypoints := make([]string, 0, len(q.Waypoints))
+		for _, w := range q.Waypoints {
+			waypoints = append(waypoints, baiduLocation(w))
+		}
+		params.Set("waypoints", strings.Join(waypoints, "|"))
+	}
+	params.Set("tactics", baiduTactics[q.Strategy])
+	if q.Alternatives {
+		params.Set("alternatives", "1")
+	}
+	params.Set("coord_type", "gcj02")
+	params.Set("ret_coordtype", "gcj02")
+	params.Set("ak", p.ak)
+	resp := &BaiduDrivingResp{}
+	if err := getJSON(ctx, p.client, "https://api.map.baidu.com/direction/v2/driving?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	// status 为 0 表示成功
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("baidu status %d: %s", resp.Status, resp.Message)
+	}
+	if len(resp.Result.Routes) == 0 {
+		return nil, biz.ErrRouteNotFound
+	}
+	routes := make([]*biz.Route, 0, len(resp.Result.Routes))
+	for _, r := range resp.Result.Routes {
+		route := &biz.Route{
+			Strategy:      q.Strategy,
+			Distance:      r.Distance,
+			Duration:      r.Duration,
+			Tolls:         r.Toll * 100,
+			TollDistance:  r.TollDistance,
+			TrafficLights: r.TrafficLight,
+		}
+		if q.Polyline {
+			var points [][2]float64
+			for _, step := range r.Steps {
+				points = appendPathPoints(points, step.Path)
+			}
+			route.Polyline = biz.EncodePolyline(points)
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// lng,lat 转为百度的 lat,lng
+func baiduLocation(location string) string {
+	lng, lat := splitLocation(location)
+	return lat + "," + lng
+}
+
+type BaiduDrivingResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  struct {
+		Routes []struct {
+			Distance     int64 `json:"distance"`      // 单位 m
+			Duration     int64 `json:"duration"`      // 单位 second
+			Toll         int64 `json:"toll"`          // 单位元
+			TollDistance int64 `json:"toll_distance"` // 单位 m
+			TrafficLight int32 `json:"traffic_light"`
+			Steps        []struct {
+				Path string `json:"path"` // lng,lat;lng,lat
+			} `json:"steps"`
+		} `json:"routes"`
+	} `json:"result"`
+}
+
+// 地理编码 geocoding/v3，只返回一个结果，ret_coordtype=gcj02ll 返回国测局坐标
+func (p *BaiduProvider) Geocode(ctx context.Context, address, city string) ([]*biz.Place, error) {
+	params := url.Values{}
+	params.Set("address", address)
+	if city != "" {
+		params.Set("city", city)
+	}
+	params.Set("ret_coordtype", "gcj02ll")
+	params.Set("output", "json")
+	params.Set("ak", p.ak)
+	resp := &BaiduGeocodeResp{}
+	if err := getJSON(ctx, p.client, "https://api.map.baidu.com/geocoding/v3/?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("baidu status %d: %s", resp.Status, resp.Message)
+	}
+	if resp.Result.Location.Lng == 0 && resp.Result.Location.Lat == 0 {
+		return nil, biz.ErrPlaceNotFound
+	}
+	return []*biz.Place{{
+		Name:     address,
+		Address:  address,
+		Location: formatLocation(resp.Result.Location.Lng, resp.Result.Location.Lat),
+	}}, nil
+}
+
+// 逆地理编码 reverse_geocoding/v3，coordtype=gcj02ll 表示传入的是国测局坐标
+func (p *BaiduProvider) ReverseGeocode(ctx context.Context, location string) (*biz.Place, error) {
+	params := url.Values{}
+	params.Set("location", baiduLocation(location))
+	params.Set("coordtype", "gcj02ll")
+	params.Set("ret_coordtype", "gcj02ll")
+	params.Set("output", "json")
+	params.Set("ak", p.ak)
+	resp := &BaiduRegeoResp{}
+	if err := getJSON(ctx, p.client, "https://api.map.baidu.com/reverse_geocoding/v3/?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("baidu status %d: %s", resp.Status, resp.Message)
+	}
+	r := resp.Result
+	if r.FormattedAddress == "" {
+		return nil, biz.ErrPlaceNotFound
+	}
+	return &biz.Place{
+		Name:     r.FormattedAddress,
+		Address:  r.FormattedAddress,
+		Location: location,
+		Province: r.AddressComponent.Province,
+		City:     r.AddressComponent.City,
+		District: r.AddressComponent.District,
+		Adcode:   r.AddressComponent.Adcode,
+	}, nil
+}
+
+// 地点输入提示 place/v2/suggestion，region 为空时为全国，coord_type=2 表示 near 为国测局坐标
+func (p *BaiduProvider) PlaceSuggest(ctx context.Context, keyword, city, near string) ([]*biz.Place, error) {
+	params := url.Values{}
+	params.Set("query", keyword)
+	if city == "" {
+		city = "全国"
+	}
+	params.Set("region", city)
+	if near != "" {
+		params.Set("location", baiduLocation(near))
+		params.Set("coord_type", "2")
+	}
+	params.Set("ret_coordtype", "gcj02ll")
+	params.Set("output", "json")
+	params.Set("ak", p.ak)
+	resp := &BaiduSuggestionResp{}
+	if err := getJSON(ctx, p.client, "https://api.map.baidu.com/place/v2/suggestion?"+params.Encode(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("baidu status %d: %s", resp.Status, resp.Message)
+	}
+	places := make([]*biz.Place, 0, len(resp.Result))
+	for _, r := range resp.Result {
+		if r.Location == nil {
+			continue
+		}
+		places = append(places, &biz.Place{
+			Name:     r.Name,
+			Address:  r.Address,
+			Location: formatLocation(r.Location.Lng, r.Location.Lat),
+			Province: r.Province,
+			City:     r.City,
+			District: r.District,
+		})
+	}
+	return places, nil
+}
+
+type BaiduLocation struct {
+	Lng float64 `json:"lng"`
+	Lat float64 `json:"lat"`
+}
+
+type BaiduGeocodeResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  struct {
+		Location BaiduLocation `json:"location"`
+	} `json:"result"`
+}
+
+type BaiduRegeoResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  struct {
+		FormattedAddress string `json:"formatted_address"`
+		AddressComponent struct {
+			Province string `json:"province"`
+			City     string `json:"city"`
+			District string `json:"district"`
+			Adcode   string `json:"adcode"`
+		} `json:"addressComponent"`
+	} `json:"result"`
+}
+
+type BaiduSuggestionResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  []struct {
+		Name     string         `json:"name"`
+		Location *BaiduLocation `json:"location"`
+		Province string         `json:"province"`
+		City     string         `json:"city"`
+		District string         `json:"district"`
+		Address  string         `json:"address"`
+	} `json:"result"`
+}
+
+// 批量算路 routematrix/v2/driving，每次请求的起点数 × 终点数不超过 50，结果按起点、终点的顺序排列
+func (p *BaiduProvider) Matrix(ctx context.Context, origins, destinations []string) ([][]*biz.MatrixCell, error) {
+	cells := newMatrix(len(origins), len(destinations))
+	for _, dc := range chunks(len(destinations), 50) {
+		for _, oc := range chunks(len(origins), 50/(dc[1]-dc[0])) {
+			params := url.Values{}
+			params.Set("origins", baiduLocations(origins[oc[0]:oc[1]]))
+			params.Set("destinations", baiduLocations(destinations[dc[0]:dc[1]]))
+			params.Set("coord_type", "gcj02")
+			params.Set("output", "json")
+			params.Set("ak", p.ak)
+			resp := &BaiduRouteMatrixResp{}
+			if err := getJSON(ctx, p.client, "https://api.map.baidu.com/routematrix/v2/driving?"+params.Encode(), resp); err != nil {
+				return nil, err
+			}
+			if resp.Status != 0 {
+				return nil, fmt.Errorf("baidu status %d: %s", resp.Status, resp.Message)
+			}
+			cols := dc[1] - dc[0]
+			for k, r := range resp.Result {
+				i, j := oc[0]+k/cols, dc[0]+k%cols
+				if i >= oc[1] {
+					break
+				}
+				cells[i][j] = &biz.MatrixCell{Distance: r.Distance.Value, Duration: r.Duration.Value}
+			}
+		}
+	}
+	return cells, nil
+}
+
+// lng,lat 列表转为百度的 lat,lng|lat,lng
+func baiduLocations(locations []string) string {
+	result := make([]string, 0, len(locations))
+	for _, l := range locations {
+		result = append(result, baiduLocation(l))
+	}
+	return strings.Join(result, "|")
+}
+
+type BaiduRouteMatrixResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  []struct {
+		Distance struct {
+			Value int64 `json:"value"` // 单位 m
+		} `json:"distance"`
+		Duration struct {
+			Value int64 `json:"value"` // 单位 second
+		} `json:"duration"`
+	} `json:"result"`
+}
@@ -0,0 +1,121 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"map/internal/biz"
+	"time"
+)
+
+type RouteCacheData struct {
+	data *Data
+}
+
+func NewRouteCache(data *Data) biz.RouteCache {
+	return &RouteCacheData{data: data}
+}
+
+// 路线缓存，string，值为 DrivingResult 的 JSON
+func routeCacheKey(key string) string {
+	return "ROUTES:" + key
+}
+
+func (rcd *RouteCacheData) GetRoutes(ctx context.Context, key string) (*biz.DrivingResult, error) {
+	value, err := rcd.data.Rdb.Get(ctx, routeCacheKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &biz.DrivingResult{}
+	if err := json.Unmarshal(value, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (rcd *RouteCacheData) SaveRoutes(ctx context.Context, key string, result *biz.DrivingResult, ttl time.Duration) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return rcd.data.Rdb.Set(ctx, routeCacheKey(key), value, ttl).Err()
+}
+
+func (rcd *RouteCacheData) MGetRoutes(ctx context.Context, keys []string) ([]*biz.DrivingResult, error) {
+	cacheKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		cacheKeys = append(cacheKeys, routeCacheKey(key))
+	}
+	values, err := rcd.data.Rdb.MGet(ctx, cacheKeys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*biz.DrivingResult, len(keys))
+	for i, value := range values {
+		// 未命中时为 nil
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		result := &biz.DrivingResult{}
+		if err := json.Unmarshal([]byte(s), result); err != nil {
+			continue
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// 使用 pipeline 一次发送
+func (rcd *RouteCacheData) MSaveRoutes(ctx context.Context, results map[string]*biz.DrivingResult, ttl time.Duration) error {
+	pipe := rcd.data.Rdb.Pipeline()
+	for key, result := range results {
+		value, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, routeCacheKey(key), value, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+type PlaceCacheData struct {
+	data *Data
+}
+
+func NewPlaceCache(data *Data) biz.PlaceCache {
+	return &PlaceCacheData{data: data}
+}
+
+// 地点缓存，string，值为 PlaceResult 的 JSON
+func placeCacheKey(key string) string {
+	return "PLACE:" + key
+}
+
+func (pcd *PlaceCacheData) GetPlaces(ctx context.Context, key string) (*biz.PlaceResult, error) {
+	value, err := pcd.data.Rdb.Get(ctx, placeCacheKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &biz.PlaceResult{}
+	if err := json.Unmarshal(value, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (pcd *PlaceCacheData) SavePlaces(ctx context.Context, key string, result *biz.PlaceResult, ttl time.Duration) error {
+	value, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return pcd.data.Rdb.Set(ctx, placeCacheKey(key), value, ttl).Err()
+}
@@ -1,6 +1,8 @@
 package data
 
 import (
+	"fmt"
+	"github.com/redis/go-redis/v9"
 	"map/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -8,17 +10,29 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewRouteProviders, NewPlaceProviders, NewRouteCache, NewPlaceCache)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
+	// 操作Redis的客户端，缓存路线
+	Rdb *redis.Client
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	data := &Data{}
+	// 连接redis，使用服务的配置，此处的redis没有配置密码
+	redisURL := fmt.Sprintf("redis://%s/5?dial_timeout=%d", c.Redis.Addr, 1)
+	options, err := redis.ParseURL(redisURL)
+	if err != nil {
+		data.Rdb = nil
+		log.Fatal(err)
+	}
+	// new client 不会立即连接，需要执行命令时才会连接
+	data.Rdb = redis.NewClient(options)
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
-	return &Data{}, cleanup, nil
+	return data, cleanup, nil
 }
@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"map/internal/biz"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// 自建的 OSRM 兼容服务（OSRM，或提供 OSRM 兼容接口的 GraphHopper 等），不依赖商业服务
+// 没有过路费和红绿灯数据；路网数据（OpenStreetMap）为 WGS-84 坐标，请求和返回的路线在此转换
+type OSRMProvider struct {
+	client  *http.Client
+	url     string
+	profile string
+}
+
+// 路线策略对应的 OSRM exclude，需要路网数据的 profile 支持对应的 class
+var osrmExcludes = map[string]string{
+	biz.RouteStrategyAvoidHighways: "motorway",
+	biz.RouteStrategyAvoidTolls:    "toll",
+}
+
+func (p *OSRMProvider) Name() string {
+	return biz.RouteProviderOSRM
+}
+
+// route/v1/{profile}/{lng},{lat};{lng},{lat}，途经点依次插入起终点之间
+func (p *OSRMProvider) Driving(ctx context.Context, q *biz.RouteQuery) ([]*biz.Route, error) {
+	profile := p.profile
+	if profile == "" {
+		profile = "driving"
+	}
+	locations := osrmLocations(append(append([]string{q.Origin}, q.Waypoints...), q.Destination))
+	params := url.Values{}
+	// OSRM 的 polyline 与 Google 算法一致，精度 1e-5，解码后转换为 GCJ-02 再编码
+	if q.Polyline {
+		params.Set("overview", "full")
+		params.Set("geometries", "polyline")
+	} else {
+		params.Set("overview", "false")
+	}
+	if q.Alternatives {
+		params.Set("alternatives", "true")
+	}
+	if exclude, ok := osrmExcludes[q.Strategy]; ok {
+		params.Set("exclude", exclude)
+	}
+	api := fmt.Sprintf("%s/route/v1/%s/%s?%s", p.url, profile, strings.Join(locations, ";"), params.Encode())
+	resp := &OSRMRouteResp{}
+	if err := getJSON(ctx, p.client, api, resp); err != nil {
+		return nil, err
+	}
+	if resp.Code == "NoRoute" {
+		return nil, biz.ErrRouteNotFound
+	}
+	if resp.Code != "Ok" {
+		return nil, fmt.Errorf("osrm code %s: %s", resp.Code, resp.Message)
+	}
+	if len(resp.Routes) == 0 {
+		return nil, biz.ErrRouteNotFound
+	}
+	routes := make([]*biz.Route, 0, len(resp.Routes))
+	for _, r := range resp.Routes {
+		polyline, err := osrmGeometry(r.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, &biz.Route{
+			Strategy: q.Strategy,
+			Distance: int64(math.Round(r.Distance)),
+			Duration: int64(math.Round(r.Duration)),
+			Polyline: polyline,
+		})
+	}
+	return routes, nil
+}
+
+type OSRMRouteResp struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Routes  []struct {
+		Distance float64 `json:"distance"` // 单位 m
+		Duration float64 `json:"duration"` // 单位 second
+		Geometry string  `json:"geometry"` // overview=false 时为空
+	} `json:"routes"`
+}
+
+// table/v1/{profile}/{坐标}，每次请求的坐标数不超过 100（OSRM 默认的 max-table-size）
+// 无法到达的单元格为 null
+func (p *OSRMProvider) Matrix(ctx context.Context, origins, destinations []string) ([][]*biz.MatrixCell, error) {
+	profile := p.profile
+	if profile == "" {
+		profile = "driving"
+	}
+	cells := newMatrix(len(origins), len(destinations))
+	dsize := len(destinations)
+	if dsize > 99 {
+		dsize = 99
+	}
+	for _, dc := range chunks(len(destinations), dsize) {
+		for _, oc := range chunks(len(origins), 100-(dc[1]-dc[0])) {
+			locations := osrmLocations(append(append([]string{}, origins[oc[0]:oc[1]]...), destinations[dc[0]:dc[1]]...))
+			sources := make([]string, 0, oc[1]-oc[0])
+			for k := 0; k < oc[1]-oc[0]; k++ {
+				sources = append(sources, strconv.Itoa(k))
+			}
+			targets := make([]string, 0, dc[1]-dc[0])
+			for k := oc[1] - oc[0]; k < len(locations); k++ {
+				targets = append(targets, strconv.Itoa(k))
+			}
+			params := url.Values{}
+			params.Set("sources", strings.Join(sources, ";"))
+			params.Set("destinations", strings.Join(targets, ";"))
+			params.Set("annotations", "duration,distance")
+			api := fmt.Sprintf("%s/table/v1/%s/%s?%s", p.url, profile, strings.Join(locations, ";"), params.Encode())
+			resp := &OSRMTableResp{}
+			if err := getJSON(ctx, p.client, api, resp); err != nil {
+				return nil, err
+			}
+			if resp.Code != "Ok" {
+				return nil, fmt.Errorf("osrm code %s: %s", resp.Code, resp.Message)
+			}
+			for a := range resp.Durations {
+				for b := range resp.Durations[a] {
+					i, j := oc[0]+a, dc[0]+b
+					if i >= oc[1] || j >= dc[1] || a >= len(resp.Distances) || b >= len(resp.Distances[a]) {
+						continue
+					}
+					duration, distance := resp.Durations[a][b], resp.Distances[a][b]
+					if duration == nil || distance == nil {
+						cells[i][j] = &biz.MatrixCell{Err: biz.ErrRouteNotFound}
+						continue
+					}
+					cells[i][j] = &biz.MatrixCell{Distance: int64(math.Round(*distance)), Duration: int64(math.Round(*duration))}
+				}
+			}
+		}
+	}
+	return cells, nil
+}
+
+type OSRMTableResp struct {
+	Code      string       `json:"code"`
+	Message   string       `json:"message"`
+	Durations [][]*float64 `json:"durations"` // 单位 second
+	Distances [][]*float64 `json:"distances"` // 单位 m
+}
+
+// 将 GCJ-02 的 lng,lat 转换为 WGS-84，格式错误的坐标原样保留
+func osrmLocations(locations []string) []string {
+	result := make([]string, 0, len(locations))
+	for _, location := range locations {
+		points := appendPathPoints(nil, location)
+		if len(points) != 1 {
+			result = append(result, location)
+			continue
+		}
+		lng, lat := biz.GCJ02ToWGS84(points[0][0], points[0][1])
+		result = append(result, strconv.FormatFloat(lng, 'f', 6, 64)+","+strconv.FormatFloat(lat, 'f', 6, 64))
+	}
+	return result
+}
+
+// 将 OSRM 返回的 WGS-84 路线转换为 GCJ-02，overview=false 时为空
+func osrmGeometry(geometry string) (string, error) {
+	if geometry == "" {
+		return "", nil
+	}
+	points, ok := biz.DecodePolyline(geometry)
+	if !ok {
+		return "", fmt.Errorf("osrm geometry is not a polyline")
+	}
+	for i, p := range points {
+		points[i][0], points[i][1] = biz.WGS84ToGCJ02(p[0], p[1])
+	}
+	return biz.EncodePolyline(points), nil
+}
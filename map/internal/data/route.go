@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"map/internal/biz"
+	"map/internal/conf"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 服务商请求超时的默认值
+const routeTimeoutDefault = 2 * time.Second
+
+// 按配置创建服务商，首选服务商在前，之后为 failover 中的服务商
+// 首选服务商未配置（例如缺少 key）时返回错误，拒绝启动；failover 中未配置的服务商被跳过
+func NewRouteProviders(mc *conf.Map, logger log.Logger) ([]biz.RouteProvider, error) {
+	timeout := mc.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = routeTimeoutDefault
+	}
+	client := &http.Client{Timeout: timeout}
+	names := append([]string{mc.GetProvider()}, mc.GetFailover()...)
+	providers := make([]biz.RouteProvider, 0, len(names))
+	added := map[string]struct{}{}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := added[name]; ok || name == "" {
+			continue
+		}
+		added[name] = struct{}{}
+		var p biz.RouteProvider
+		switch name {
+		case biz.RouteProviderAMap:
+			if mc.GetAmap().GetKey() != "" {
+				p = &AMapProvider{client: client, key: mc.GetAmap().GetKey()}
+			}
+		case biz.RouteProviderBaidu:
+			if mc.GetBaidu().GetAk() != "" {
+				p = &BaiduProvider{client: client, ak: mc.GetBaidu().GetAk()}
+			}
+		case biz.RouteProviderOSRM:
+			if mc.GetOsrm().GetUrl() != "" {
+				p = &OSRMProvider{client: client, url: strings.TrimRight(mc.GetOsrm().GetUrl(), "/"), profile: mc.GetOsrm().GetProfile()}
+			}
+		default:
+			return nil, fmt.Errorf("unknown map provider %q", name)
+		}
+		if p == nil && len(providers) == 0 && len(added) == 1 {
+			return nil, fmt.Errorf("map provider %s is not configured, check the key in the map config", name)
+		}
+		if p == nil {
+			log.NewHelper(logger).Warnf("map provider %s is not configured, skipped", name)
+			continue
+		}
+		providers = append(providers, p)
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no map provider configured")
+	}
+	return providers, nil
+}
+
+// 路线服务商中同时提供地点服务的，保持相同的优先级
+func NewPlaceProviders(routes []biz.RouteProvider) []biz.PlaceProvider {
+	providers := make([]biz.PlaceProvider, 0, len(routes))
+	for _, r := range routes {
+		if p, ok := r.(biz.PlaceProvider); ok {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// 发出 GET 请求，解析 JSON 响应
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && len(body) == 0 {
+		return fmt.Errorf("http status %d", resp.StatusCode)
+	}
+	return json.Unmarshal(body, v)
+}
+
+// lng,lat 拆分为两部分
+func splitLocation(location string) (lng, lat string) {
+	parts := strings.SplitN(location, ",", 2)
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// 解析 lng,lat;lng,lat 格式的坐标串（高德和百度的路段坐标），追加到 points
+func appendPathPoints(points [][2]float64, path string) [][2]float64 {
+	for _, location := range strings.Split(path, ";") {
+		parts := strings.Split(location, ",")
+		if len(parts) != 2 {
+			continue
+		}
+		lng, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		lat, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		points = append(points, [2]float64{lng, lat})
+	}
+	return points
+}
+
+// 按 size 分段，返回每段的 [start, end)
+func chunks(n, size int) [][2]int {
+	if size < 1 {
+		size = 1
+	}
+	result := make([][2]int, 0, (n+size-1)/size)
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		result = append(result, [2]int{start, end})
+	}
+	return result
+}
+
+// 创建 rows 行、cols 列的矩阵
+func newMatrix(rows, cols int) [][]*biz.MatrixCell {
+	cells := make([][]*biz.MatrixCell, rows)
+	for i := range cells {
+		cells[i] = make([]*biz.MatrixCell, cols)
+	}
+	return cells
+}
+
+// 坐标格式化为 lng,lat
+func formatLocation(lng, lat float64) string {
+	return strconv.FormatFloat(lng, 'f', 6, 64) + "," + strconv.FormatFloat(lat, 'f', 6, 64)
+}
+
+// 金额由元转为分，空字符串为 0
+func yuanToCents(yuan string) (int64, error) {
+	if yuan == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(yuan, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(v * 100)), nil
+}
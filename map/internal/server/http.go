@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	v1 "map/api/helloworld/v1"
 	"map/internal/conf"
 	"map/internal/service"
@@ -28,5 +29,7 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
+	// 运行时统计（expvar），包括路线缓存的命中情况
+	srv.Handle("/debug/vars", expvar.Handler())
 	return srv
 }
@@ -5,28 +5,130 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	pb "map/api/mapService"
 	"map/internal/biz"
+	"strconv"
+	"time"
 )
 
 type MapServiceService struct {
 	pb.UnimplementedMapServiceServer
 	msbiz *biz.MapServiceBiz
+	pbiz  *biz.PlaceBiz
 }
 
-func NewMapServiceService(msbiz *biz.MapServiceBiz) *MapServiceService {
+func NewMapServiceService(msbiz *biz.MapServiceBiz, pbiz *biz.PlaceBiz) *MapServiceService {
 	return &MapServiceService{
 		msbiz: msbiz,
+		pbiz:  pbiz,
 	}
 }
 
 func (s *MapServiceService) GetDrivingInfo(ctx context.Context, req *pb.GetDrivingInfoReq) (*pb.GetDrivingReply, error) {
-	distance, duration, err := s.msbiz.GetDriverInfo(req.Origin, req.Destination)
+	result, err := s.msbiz.Driving(ctx, &biz.RouteQuery{
+		Origin:       req.Origin,
+		Destination:  req.Destination,
+		Strategy:     req.Strategy,
+		Waypoints:    req.Waypoints,
+		Alternatives: req.Alternatives,
+		Polyline:     req.Polyline,
+		City:         req.City,
+		DepartureAt:  unixTime(req.DepartureAt),
+	})
 	if err != nil {
-		return nil, errors.New(200, "LBS_ERROR", "lbs api error")
+		return nil, err
 	}
+	routes := make([]*pb.DrivingRoute, 0, len(result.Routes))
+	for _, r := range result.Routes {
+		routes = append(routes, &pb.DrivingRoute{
+			Strategy:      r.Strategy,
+			Distance:      r.Distance,
+			Duration:      r.Duration,
+			Tolls:         r.Tolls,
+			TollDistance:  r.TollDistance,
+			TrafficLights: r.TrafficLights,
+			Polyline:      r.Polyline,
+		})
+	}
+	// biz 保证至少有一条路线
 	return &pb.GetDrivingReply{
 		Origin:      req.Origin,
 		Destination: req.Destination,
-		Distance:    distance,
-		Duration:    duration,
+		Distance:    strconv.FormatInt(result.Routes[0].Distance, 10),
+		Duration:    strconv.FormatInt(result.Routes[0].Duration, 10),
+		Routes:      routes,
+		Provider:    result.Provider,
+		Estimated:   result.Estimated,
 	}, nil
 }
+
+func (s *MapServiceService) GetDistanceMatrix(ctx context.Context, req *pb.GetDistanceMatrixReq) (*pb.GetDistanceMatrixReply, error) {
+	cells, err := s.msbiz.DistanceMatrix(ctx, req.Origins, req.Destinations, req.City)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*pb.MatrixRow, 0, len(cells))
+	for _, row := range cells {
+		r := &pb.MatrixRow{Cells: make([]*pb.MatrixCell, 0, len(row))}
+		for _, c := range row {
+			cell := &pb.MatrixCell{Distance: c.Distance, Duration: c.Duration, Estimated: c.Estimated}
+			if c.Err != nil {
+				e := errors.FromError(c.Err)
+				cell = &pb.MatrixCell{Reason: e.Reason, Message: e.Message}
+			}
+			r.Cells = append(r.Cells, cell)
+		}
+		rows = append(rows, r)
+	}
+	return &pb.GetDistanceMatrixReply{Rows: rows}, nil
+}
+
+func (s *MapServiceService) Geocode(ctx context.Context, req *pb.GeocodeReq) (*pb.PlacesReply, error) {
+	result, err := s.pbiz.Geocode(ctx, req.Address, req.City)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlacesReply{Places: toPlaces(result.Places), Provider: result.Provider}, nil
+}
+
+func (s *MapServiceService) ReverseGeocode(ctx context.Context, req *pb.ReverseGeocodeReq) (*pb.ReverseGeocodeReply, error) {
+	place, provider, err := s.pbiz.ReverseGeocode(ctx, req.Lng, req.Lat)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReverseGeocodeReply{Place: toPlace(place), Provider: provider}, nil
+}
+
+func (s *MapServiceService) PlaceSuggest(ctx context.Context, req *pb.PlaceSuggestReq) (*pb.PlacesReply, error) {
+	result, err := s.pbiz.PlaceSuggest(ctx, req.Keyword, req.City, req.Near)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PlacesReply{Places: toPlaces(result.Places), Provider: result.Provider}, nil
+}
+
+func toPlace(p *biz.Place) *pb.Place {
+	return &pb.Place{
+		Name:     p.Name,
+		Address:  p.Address,
+		Location: p.Location,
+		Province: p.Province,
+		City:     p.City,
+		District: p.District,
+		Adcode:   p.Adcode,
+	}
+}
+
+func toPlaces(places []*biz.Place) []*pb.Place {
+	result := make([]*pb.Place, 0, len(places))
+	for _, p := range places {
+		result = append(result, toPlace(p))
+	}
+	return result
+}
+
+// unix timestamp 转为时间，0 为零值
+func unixTime(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
@@ -0,0 +1,72 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"order/internal/biz"
+	"order/internal/conf"
+	"order/internal/data"
+	"order/internal/server"
+	"order/internal/service"
+)
+
+import (
+	_ "go.uber.org/automaxprocs"
+)
+
+// Injectors from wire.go:
+
+// wireApp init kratos application.
+func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, auth *conf.Auth, quote *conf.Quote, logger log.Logger) (*kratos.App, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	greeterRepo := data.NewGreeterRepo(dataData, logger)
+	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
+	greeterService := service.NewGreeterService(greeterUsecase)
+	orderInterface := data.NewOrderInterface(dataData)
+	offerInterface := data.NewOfferInterface(dataData)
+	clientFactory, cleanup2, err := data.NewClientFactory(confService, auth, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	valuationClient, err := data.NewValuationClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	settleInterface := data.NewSettleInterface(valuationClient)
+	orderBiz := biz.NewOrderBiz(orderInterface, offerInterface, settleInterface, quote, logger)
+	driverClient, err := data.NewDriverClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	mapServiceClient, err := data.NewMapServiceClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	dispatchInterface := data.NewDispatchInterface(dataData, driverClient, mapServiceClient, valuationClient)
+	dispatchBiz := biz.NewDispatchBiz(dispatchInterface, offerInterface, orderBiz, logger)
+	orderService := service.NewOrderService(orderBiz, dispatchBiz)
+	grpcServer := server.NewGRPCServer(confServer, auth, greeterService, orderService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
+	dispatchServer := server.NewDispatchServer(dispatchBiz, logger)
+	app := newApp(confService, logger, grpcServer, httpServer, dispatchServer)
+	return app, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
@@ -0,0 +1,365 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 派单参数
+const DispatchAcceptTimeout = 15    // 每次派单等待司机接单的时间，单位 second
+const DispatchOfferSize = 3         // 每次同时派给的司机数
+const DispatchCandidateLimit = 20   // 每轮查询的候选司机数
+const DispatchMaxDuration = 10 * 60 // 一个订单派单的最长时间，单位 second
+const DispatchCancelReason = "附近暂无可接单的司机"
+
+// 派单恢复参数：服务重启后，派单任务随进程丢失，定时扫描仍等待接单的订单
+const DispatchSweepInterval = 60 // 扫描间隔，单位 second
+const DispatchSweepGrace = 30    // 下单超过该时长才扫描，避开刚创建的订单，单位 second
+const DispatchSweepLimit = 100   // 每次扫描的订单数
+
+// 派单任务锁的有效期，派单期间每批派单前续期，进程退出后锁很快过期，订单可被恢复，单位 second
+const DispatchLockTTL = 3 * DispatchAcceptTimeout
+
+// 逐轮扩大的搜索半径，单位 m
+var DispatchRadii = []float64{3000, 5000, 10000}
+
+// 评分权重，分数越低越优先
+const dispatchDurationWeight = 1.0 // 每秒
+const dispatchDistanceWeight = 0.1 // 每米
+
+// 地图服务不可用时，按直线距离和该速度估算 ETA，单位 m/s
+const dispatchFallbackSpeed = 8.0
+
+// 等待接单时查询订单状态的间隔
+const dispatchPollInterval = time.Second
+
+var (
+	ErrOrderOffer    = errors.Forbidden("ORDER_OFFER_ERROR", "order was not offered to the driver or the offer expired")
+	ErrOrderLocation = errors.BadRequest("ORDER_LOCATION_ERROR", "origin must be lng,lat")
+	ErrDriverBusy    = errors.Conflict("DRIVER_BUSY", "driver has an order in progress")
+)
+
+// 派单的候选司机
+type Candidate struct {
+	DriverID uint
+	Lng      float64
+	Lat      float64
+	Distance int64 // 到起点的距离，单位 m，计算 ETA 前为直线距离
+	Duration int64 // 到起点的时长，单位 second
+	Score    float64
+}
+
+// 到起点的驾驶距离和时长
+type ETA struct {
+	Distance int64 // 单位 m
+	Duration int64 // 单位 second
+}
+
+// 派单锁相关的资源操作接口
+type OfferInterface interface {
+	// 将订单派给司机，司机已持有其他派单时返回 false，保证一个司机同一时刻只会收到一个订单
+	LockOffer(ctx context.Context, driverID, orderID uint, ttl time.Duration) (bool, error)
+	// 获取派给司机的订单，没有时 orderID 为 0
+	GetOffer(ctx context.Context, driverID uint) (orderID uint, expireAt time.Time, err error)
+	// 释放派单，仅当派单仍属于 orderID 时释放
+	ReleaseOffer(ctx context.Context, driverID, orderID uint) error
+	// 派单任务锁，保证一个订单同一时刻只有一个派单任务，token 标识持有锁的任务
+	LockDispatch(ctx context.Context, orderID uint, token string, ttl time.Duration) (bool, error)
+	// 派单任务锁续期，锁已不属于 token 时返回 false
+	RenewDispatch(ctx context.Context, orderID uint, token string, ttl time.Duration) (bool, error)
+	// 释放派单任务锁，仅当锁仍属于 token 时释放
+	ReleaseDispatch(ctx context.Context, orderID uint, token string) error
+}
+
+// 派单依赖的外部服务
+type DispatchInterface interface {
+	// 附近的听单司机（Driver 服务）
+	NearbyDrivers(ctx context.Context, city string, lng, lat, radius float64, limit int) ([]*Candidate, error)
+	// 多个起点到终点的驾驶距离(m)和时长(s)，与 origins 一一对应，无法计算的为 nil（Map 服务的距离矩阵）
+	DrivingETAs(ctx context.Context, origins []string, destination, city string) ([]*ETA, error)
+	// 上报等待接单的订单，open 为 false 表示订单已离开等待接单的状态（Valuation 服务）
+	ReportDemand(ctx context.Context, order *Order, open bool) error
+}
+
+// 派单业务逻辑
+type DispatchBiz struct {
+	DI  DispatchInterface
+	FI  OfferInterface
+	ob  *OrderBiz
+	log *log.Helper
+}
+
+// DispatchBiz 构造器
+func NewDispatchBiz(di DispatchInterface, fi OfferInterface, ob *OrderBiz, logger log.Logger) *DispatchBiz {
+	return &DispatchBiz{
+		DI:  di,
+		FI:  fi,
+		ob:  ob,
+		log: log.NewHelper(logger),
+	}
+}
+
+// 异步派单，不阻塞下单请求
+// 派单的截止时间从下单时间算起，恢复的派单任务只使用剩余的时间
+func (db *DispatchBiz) Dispatch(order *Order) {
+	go func() {
+		ctx, cancel := context.WithDeadline(context.Background(), dispatchDeadline(order))
+		defer cancel()
+		if err := db.dispatch(ctx, order); err != nil {
+			db.log.Errorf("dispatch order %d: %v", order.ID, err)
+		}
+	}()
+}
+
+// 派单的截止时间
+func dispatchDeadline(order *Order) time.Time {
+	createdAt := order.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return createdAt.Add(DispatchMaxDuration * time.Second)
+}
+
+// 恢复派单，服务启动时和每隔 DispatchSweepInterval 执行一次
+// 仍等待接单的订单：未超过派单时长的重新派单（派单任务锁保证不会与进行中的任务重复），超过的系统取消
+func (db *DispatchBiz) Recover(ctx context.Context) error {
+	now := time.Now()
+	orders, err := db.ob.OI.StaleOrders(ctx, OrderStatusCreated, now.Add(-DispatchSweepGrace*time.Second), DispatchSweepLimit)
+	if err != nil {
+		return err
+	}
+	for _, order := range orders {
+		if dispatchDeadline(order).After(now) {
+			db.Dispatch(order)
+			continue
+		}
+		if _, err := db.ob.CancelOrderBySystem(ctx, order.ID, DispatchCancelReason); err != nil {
+			if !errors.Is(err, ErrOrderStatus) && !errors.Is(err, ErrOrderConflict) {
+				db.log.WithContext(ctx).Errorf("cancel stale order %d: %v", order.ID, err)
+			}
+			continue
+		}
+		// 派单任务中断时没有撤回需求
+		if err := db.DI.ReportDemand(ctx, order, false); err != nil {
+			db.log.WithContext(ctx).Warnf("withdraw demand of order %d: %v", order.ID, err)
+		}
+		db.log.WithContext(ctx).Infof("stale order %d canceled", order.ID)
+	}
+	return nil
+}
+
+// 派单流程
+// 一，按半径由小到大查询候选司机；二，计算 ETA 并评分；三，按评分分批派单，等待接单；
+// 四，所有半径都无人接单时，取消订单
+func (db *DispatchBiz) dispatch(ctx context.Context, order *Order) error {
+	token := uuid.NewString()
+	ok, err := db.FI.LockDispatch(ctx, order.ID, token, DispatchLockTTL*time.Second)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 已有派单任务
+		return nil
+	}
+	defer func() {
+		_ = db.FI.ReleaseDispatch(context.Background(), order.ID, token)
+	}()
+	// 派单期间的订单计入起点区域的需求，用于动态调价，上报失败不影响派单
+	if err := db.DI.ReportDemand(ctx, order, true); err != nil {
+		db.log.Warnf("report demand of order %d: %v", order.ID, err)
+	}
+	defer func() {
+		if err := db.DI.ReportDemand(context.Background(), order, false); err != nil {
+			db.log.Warnf("withdraw demand of order %d: %v", order.ID, err)
+		}
+	}()
+	lng, lat, err := ParseLocation(order.Origin)
+	if err != nil {
+		return err
+	}
+	// 已经派过的司机，扩大半径后不再重复派单
+	offered := map[uint]struct{}{}
+	for _, radius := range DispatchRadii {
+		candidates, err := db.candidates(ctx, order, lng, lat, radius, offered)
+		if err != nil {
+			db.log.Warnf("dispatch order %d candidates in %.0fm: %v", order.ID, radius, err)
+			continue
+		}
+		for len(candidates) > 0 {
+			n := DispatchOfferSize
+			if n > len(candidates) {
+				n = len(candidates)
+			}
+			done, err := db.offer(ctx, order.ID, token, candidates[:n], offered)
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+			candidates = candidates[n:]
+		}
+	}
+	// 无人接单，系统取消。订单在最后时刻被接单或被顾客取消时，状态迁移会失败，忽略即可
+	if _, err := db.ob.CancelOrderBySystem(ctx, order.ID, DispatchCancelReason); err != nil &&
+		!errors.Is(err, ErrOrderStatus) && !errors.Is(err, ErrOrderConflict) {
+		return err
+	}
+	return nil
+}
+
+// 查询候选司机，过滤已派过的和有进行中订单的司机，按评分排序
+func (db *DispatchBiz) candidates(ctx context.Context, order *Order, lng, lat, radius float64, offered map[uint]struct{}) ([]*Candidate, error) {
+	nearby, err := db.DI.NearbyDrivers(ctx, order.City, lng, lat, radius, DispatchCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(nearby))
+	for _, c := range nearby {
+		ids = append(ids, c.DriverID)
+	}
+	busy, err := db.ob.OI.BusyDrivers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*Candidate, 0, len(nearby))
+	for _, c := range nearby {
+		if _, ok := offered[c.DriverID]; ok {
+			continue
+		}
+		if _, ok := busy[c.DriverID]; ok {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	// 一次请求计算所有司机到起点的 ETA
+	var etas []*ETA
+	if len(candidates) > 0 {
+		origins := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			origins = append(origins, FormatLocation(c.Lng, c.Lat))
+		}
+		if etas, err = db.DI.DrivingETAs(ctx, origins, order.Origin, order.City); err != nil {
+			db.log.WithContext(ctx).Warnf("dispatch order %d driving etas: %v", order.ID, err)
+		}
+	}
+	for i, c := range candidates {
+		if i < len(etas) && etas[i] != nil {
+			c.Distance, c.Duration = etas[i].Distance, etas[i].Duration
+		} else {
+			// 地图服务不可用或无法计算时，使用直线距离估算
+			c.Duration = int64(float64(c.Distance) / dispatchFallbackSpeed)
+		}
+		c.Score = dispatchDurationWeight*float64(c.Duration) + dispatchDistanceWeight*float64(c.Distance)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score < candidates[j].Score
+	})
+	return candidates, nil
+}
+
+// 将订单同时派给一批司机，等待接单
+// 返回 true 表示派单结束（已被接单或已取消）
+func (db *DispatchBiz) offer(ctx context.Context, orderID uint, token string, batch []*Candidate, offered map[uint]struct{}) (bool, error) {
+	// 上一批派单超时的瞬间可能被接单或取消
+	if done, err := db.finished(ctx, orderID); err != nil || done {
+		return done, err
+	}
+	// 锁已过期并被恢复的派单任务持有时，由对方继续派单
+	if ok, err := db.FI.RenewDispatch(ctx, orderID, token, DispatchLockTTL*time.Second); err != nil || !ok {
+		return true, err
+	}
+	ttl := DispatchAcceptTimeout * time.Second
+	locked := make([]uint, 0, len(batch))
+	for _, c := range batch {
+		offered[c.DriverID] = struct{}{}
+		ok, err := db.FI.LockOffer(ctx, c.DriverID, orderID, ttl)
+		if err != nil || !ok {
+			// 司机正持有其他订单的派单
+			continue
+		}
+		locked = append(locked, c.DriverID)
+	}
+	if len(locked) == 0 {
+		return false, nil
+	}
+	defer func() {
+		for _, driverID := range locked {
+			_ = db.FI.ReleaseOffer(context.Background(), driverID, orderID)
+		}
+	}()
+	// 等待接单
+	ticker := time.NewTicker(dispatchPollInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(ttl)
+	defer timeout.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-timeout.C:
+			return false, nil
+		case <-ticker.C:
+			if done, err := db.finished(ctx, orderID); err == nil && done {
+				return true, nil
+			}
+		}
+	}
+}
+
+// 订单是否已离开等待接单的状态
+func (db *DispatchBiz) finished(ctx context.Context, orderID uint) (bool, error) {
+	order, err := db.ob.GetOrder(ctx, orderID)
+	if err != nil {
+		return false, err
+	}
+	return order.Status != OrderStatusCreated, nil
+}
+
+// 获取派给司机的订单，没有时返回 nil
+func (db *DispatchBiz) GetOffer(ctx context.Context, driverID uint) (*Order, time.Time, error) {
+	orderID, expireAt, err := db.FI.GetOffer(ctx, driverID)
+	if err != nil || orderID == 0 {
+		return nil, time.Time{}, err
+	}
+	order, err := db.ob.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if order.Status != OrderStatusCreated {
+		return nil, time.Time{}, nil
+	}
+	return order, expireAt, nil
+}
+
+// 解析 lng,lat 格式的坐标
+func ParseLocation(location string) (lng, lat float64, err error) {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return 0, 0, ErrOrderLocation
+	}
+	if lng, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err != nil {
+		return 0, 0, ErrOrderLocation
+	}
+	if lat, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+		return 0, 0, ErrOrderLocation
+	}
+	// ParseFloat 接受 NaN 和 Inf，NaN 与任何数比较都为 false，需要单独排除
+	if math.IsNaN(lng) || math.IsNaN(lat) || math.IsInf(lng, 0) || math.IsInf(lat, 0) ||
+		lng < -180 || lng > 180 || lat < -90 || lat > 90 {
+		return 0, 0, ErrOrderLocation
+	}
+	return lng, lat, nil
+}
+
+// 格式化为 lng,lat，保留 6 位小数（高德地图的精度）
+func FormatLocation(lng, lat float64) string {
+	return strconv.FormatFloat(lng, 'f', 6, 64) + "," + strconv.FormatFloat(lat, 'f', 6, 64)
+}
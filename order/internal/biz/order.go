@@ -0,0 +1,394 @@
+package biz
+
+import (
+	"common/quote"
+	"context"
+	"database/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"order/internal/conf"
+	"strings"
+	"time"
+)
+
+// 订单状态常量
+const OrderStatusCreated = "created"   // 已下单，等待司机接单
+const OrderStatusAccepted = "accepted" // 司机已接单
+const OrderStatusArrived = "arrived"   // 司机已到达起点
+const OrderStatusStarted = "started"   // 代驾中
+const OrderStatusFinished = "finished" // 代驾结束，待支付
+const OrderStatusPaid = "paid"         // 已支付
+const OrderStatusCanceled = "canceled" // 已取消
+
+// 全部合法的订单状态
+var orderStatuses = map[string]struct{}{
+	OrderStatusCreated:  {},
+	OrderStatusAccepted: {},
+	OrderStatusArrived:  {},
+	OrderStatusStarted:  {},
+	OrderStatusFinished: {},
+	OrderStatusPaid:     {},
+	OrderStatusCanceled: {},
+}
+
+// 触发状态迁移的事件
+const OrderEventAccept = "accept"
+const OrderEventArrive = "arrive"
+const OrderEventStart = "start"
+const OrderEventFinish = "finish"
+const OrderEventPay = "pay"
+const OrderEventCancel = "cancel"
+
+// 订单状态机，当前状态 + 事件 => 目标状态
+// 不在表中的迁移都是非法迁移
+var orderTransitions = map[string]map[string]string{
+	OrderStatusCreated: {
+		OrderEventAccept: OrderStatusAccepted,
+		OrderEventCancel: OrderStatusCanceled,
+	},
+	OrderStatusAccepted: {
+		OrderEventArrive: OrderStatusArrived,
+		OrderEventCancel: OrderStatusCanceled,
+	},
+	OrderStatusArrived: {
+		OrderEventStart:  OrderStatusStarted,
+		OrderEventCancel: OrderStatusCanceled,
+	},
+	OrderStatusStarted: {
+		OrderEventFinish: OrderStatusFinished,
+	},
+	OrderStatusFinished: {
+		OrderEventPay: OrderStatusPaid,
+	},
+}
+
+var (
+	ErrOrderNotFound  = errors.NotFound("ORDER_NOT_FOUND", "order not found")
+	ErrOrderParam     = errors.BadRequest("ORDER_PARAM_ERROR", "order param error")
+	ErrOrderStatus    = errors.BadRequest("ORDER_STATUS_ERROR", "illegal order status transition")
+	ErrOrderForbidden = errors.Forbidden("ORDER_FORBIDDEN", "order does not belong to the operator")
+	ErrOrderConflict  = errors.Conflict("ORDER_CONFLICT", "order status was changed by others")
+	ErrOrderAmount    = errors.BadRequest("ORDER_AMOUNT_ERROR", "pay amount does not match the fare")
+	ErrQuoteInvalid   = errors.BadRequest("QUOTE_INVALID", "quote token is invalid")
+	ErrQuoteExpired   = errors.BadRequest("QUOTE_EXPIRED", "报价已过期，请重新预估")
+	ErrQuoteMismatch  = errors.BadRequest("QUOTE_MISMATCH", "origin or destination does not match the quote")
+)
+
+// 订单表模型
+type Order struct {
+	// 基础模型
+	gorm.Model
+	// 业务模型
+	OrderWork
+}
+
+// 订单的业务模型
+type OrderWork struct {
+	CustomerID   uint           `gorm:"index;" json:"customer_id"`
+	DriverID     sql.NullInt64  `gorm:"index;" json:"driver_id"`
+	Origin       string         `gorm:"type:varchar(255);" json:"origin"`
+	Destination  string         `gorm:"type:varchar(255);" json:"destination"`
+	City         string         `gorm:"type:varchar(16);" json:"city"` // 城市编码，用于派单
+	Price        int64          `gorm:"" json:"price"`                 // 预估价格
+	FinalPrice   sql.NullInt64  `gorm:"" json:"final_price"`           // 实际费用
+	Status       string         `gorm:"type:enum('created','accepted','arrived','started','finished','paid','canceled');index;" json:"status"`
+	CancelReason sql.NullString `gorm:"type:varchar(255);" json:"cancel_reason"`
+	AcceptedAt   sql.NullTime   `gorm:"" json:"accepted_at"`
+	ArrivedAt    sql.NullTime   `gorm:"" json:"arrived_at"`
+	StartedAt    sql.NullTime   `gorm:"" json:"started_at"`
+	FinishedAt   sql.NullTime   `gorm:"" json:"finished_at"`
+	PaidAt       sql.NullTime   `gorm:"" json:"paid_at"`
+	CanceledAt   sql.NullTime   `gorm:"" json:"canceled_at"`
+	// 下单时的报价，结算时沿用报价的动态调价倍数和路线的过路费
+	QuoteID         string  `gorm:"type:varchar(64);index;" json:"quote_id"`
+	SurgeMultiplier float64 `gorm:"" json:"surge_multiplier"`
+	Tolls           int64   `gorm:"" json:"tolls"`
+}
+
+// 订单相关的资源操作接口
+type OrderInterface interface {
+	// 创建订单
+	CreateOrder(context.Context, *Order) error
+	// 获取订单
+	GetOrder(context.Context, uint) (*Order, error)
+	// 状态迁移，仅当订单仍处于 from 状态时才更新，用于并发控制
+	TransitOrder(ctx context.Context, id uint, from string, values map[string]interface{}) error
+	// 按条件分页查询订单，同时返回总数
+	ListOrders(context.Context, *OrderFilter) ([]*Order, int64, error)
+	// 从 ids 中筛选出有进行中订单（已接单、已到达、代驾中）的司机
+	BusyDrivers(ctx context.Context, ids []uint) (map[uint]struct{}, error)
+	// 在 before 之前下单且仍处于 status 状态的订单，按下单时间正序，最多 limit 个
+	StaleOrders(ctx context.Context, status string, before time.Time, limit int) ([]*Order, error)
+}
+
+// 结算相关的外部服务
+type SettleInterface interface {
+	// 按里程表距离和实际起止时间结算，以订单的预估价格为报价，沿用报价的动态调价倍数和过路费，返回实际费用（Valuation 服务）
+	SettleFare(ctx context.Context, order *Order, odometer int64, endedAt time.Time) (int64, error)
+}
+
+// 分页参数的默认值与上限
+const OrderPageSizeDefault = 10
+const OrderPageSizeMax = 100
+
+// 订单查询条件，零值表示不过滤
+type OrderFilter struct {
+	CustomerID  uint
+	DriverID    uint
+	Status      string
+	CreatedFrom time.Time // 包含
+	CreatedTo   time.Time // 不包含
+	Page        int
+	PageSize    int
+}
+
+// 订单业务逻辑
+type OrderBiz struct {
+	OI    OrderInterface
+	FI    OfferInterface
+	SI    SettleInterface
+	quote *conf.Quote
+	log   *log.Helper
+}
+
+// OrderBiz 构造器
+func NewOrderBiz(oi OrderInterface, fi OfferInterface, si SettleInterface, qc *conf.Quote, logger log.Logger) *OrderBiz {
+	return &OrderBiz{
+		OI:    oi,
+		FI:    fi,
+		SI:    si,
+		quote: qc,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// 根据当前状态和事件，计算迁移后的状态
+func NextOrderStatus(status, event string) (string, error) {
+	next, ok := orderTransitions[status][event]
+	if !ok {
+		return "", ErrOrderStatus.WithMetadata(map[string]string{
+			"status": status,
+			"event":  event,
+		})
+	}
+	return next, nil
+}
+
+// 创建订单，初始状态为 created
+// 起点需要是 lng,lat 格式的坐标，派单时据此查询附近的司机
+// 价格和城市以报价令牌为准，不信任调用方传入的价格，起终点需与报价一致
+func (ob *OrderBiz) CreateOrder(ctx context.Context, customerID uint, origin, destination, quoteToken string) (*Order, error) {
+	origin, destination = strings.TrimSpace(origin), strings.TrimSpace(destination)
+	if customerID == 0 || origin == "" || destination == "" {
+		return nil, ErrOrderParam
+	}
+	claims, err := ob.VerifyQuote(quoteToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Origin != origin || claims.Destination != destination {
+		return nil, ErrQuoteMismatch
+	}
+	if claims.City == "" {
+		return nil, ErrOrderParam
+	}
+	if _, _, err := ParseLocation(origin); err != nil {
+		return nil, err
+	}
+	order := &Order{}
+	order.CustomerID = customerID
+	order.Origin = origin
+	order.Destination = destination
+	order.City = claims.City
+	order.Price = claims.Price
+	order.QuoteID = claims.ID
+	order.SurgeMultiplier = claims.Surge
+	order.Tolls = claims.Tolls
+	order.Status = OrderStatusCreated
+	if err := ob.OI.CreateOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// 离线校验报价令牌：HS256 签名、签发方和有效期，不调用 Valuation 服务
+func (ob *OrderBiz) VerifyQuote(token string) (*quote.Claims, error) {
+	claims, err := quote.Parse(token, ob.quote.GetSecret(), ob.quote.GetIssuer())
+	if errors.Is(err, quote.ErrExpired) {
+		return nil, ErrQuoteExpired
+	}
+	if err != nil {
+		return nil, ErrQuoteInvalid
+	}
+	return claims, nil
+}
+
+// 获取订单
+func (ob *OrderBiz) GetOrder(ctx context.Context, id uint) (*Order, error) {
+	return ob.OI.GetOrder(ctx, id)
+}
+
+// 订单列表，按下单时间倒序
+func (ob *OrderBiz) ListOrders(ctx context.Context, filter *OrderFilter) ([]*Order, int64, error) {
+	if filter.Status != "" {
+		if _, ok := orderStatuses[filter.Status]; !ok {
+			return nil, 0, ErrOrderParam
+		}
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		return nil, 0, ErrOrderParam
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = OrderPageSizeDefault
+	}
+	if filter.PageSize > OrderPageSizeMax {
+		filter.PageSize = OrderPageSizeMax
+	}
+	return ob.OI.ListOrders(ctx, filter)
+}
+
+// 顾客取消订单，代驾开始后不可取消
+func (ob *OrderBiz) CancelOrder(ctx context.Context, id, customerID uint, reason string) (*Order, error) {
+	return ob.transit(ctx, id, OrderEventCancel, func(o *Order) error {
+		if o.CustomerID != customerID {
+			return ErrOrderForbidden
+		}
+		return nil
+	}, map[string]interface{}{
+		"cancel_reason": sql.NullString{String: reason, Valid: reason != ""},
+		"canceled_at":   sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// 系统取消订单，例如派单无人接单
+func (ob *OrderBiz) CancelOrderBySystem(ctx context.Context, id uint, reason string) (*Order, error) {
+	return ob.transit(ctx, id, OrderEventCancel, nil, map[string]interface{}{
+		"cancel_reason": sql.NullString{String: reason, Valid: reason != ""},
+		"canceled_at":   sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// 司机接单，只能接派给自己且未过期的订单
+// 订单的条件更新保证一个订单只会被接单一次
+func (ob *OrderBiz) AcceptOrder(ctx context.Context, id, driverID uint) (*Order, error) {
+	if driverID == 0 {
+		return nil, ErrOrderParam
+	}
+	offerID, _, err := ob.FI.GetOffer(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if offerID != id {
+		return nil, ErrOrderOffer
+	}
+	busy, err := ob.OI.BusyDrivers(ctx, []uint{driverID})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := busy[driverID]; ok {
+		return nil, ErrDriverBusy
+	}
+	order, err := ob.transit(ctx, id, OrderEventAccept, nil, map[string]interface{}{
+		"driver_id":   sql.NullInt64{Int64: int64(driverID), Valid: true},
+		"accepted_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	_ = ob.FI.ReleaseOffer(ctx, driverID, id)
+	return order, nil
+}
+
+// 司机到达起点
+func (ob *OrderBiz) ArriveOrder(ctx context.Context, id, driverID uint) (*Order, error) {
+	return ob.transit(ctx, id, OrderEventArrive, driverCheck(driverID), map[string]interface{}{
+		"arrived_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// 开始代驾
+func (ob *OrderBiz) StartOrder(ctx context.Context, id, driverID uint) (*Order, error) {
+	return ob.transit(ctx, id, OrderEventStart, driverCheck(driverID), map[string]interface{}{
+		"started_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// 结束代驾，实际费用由服务端结算，不信任司机传入的价格
+// 有里程表距离时通过 Valuation 按规则结算（不超过报价的上浮上限），没有或结算失败时使用预估价格
+func (ob *OrderBiz) FinishOrder(ctx context.Context, id, driverID uint, odometer int64) (*Order, error) {
+	if odometer < 0 {
+		return nil, ErrOrderParam
+	}
+	now := time.Now()
+	values := map[string]interface{}{
+		"finished_at": sql.NullTime{Time: now, Valid: true},
+	}
+	return ob.transit(ctx, id, OrderEventFinish, func(o *Order) error {
+		if err := driverCheck(driverID)(o); err != nil {
+			return err
+		}
+		finalPrice := o.Price
+		if odometer > 0 && o.Status == OrderStatusStarted {
+			price, err := ob.SI.SettleFare(ctx, o, odometer, now)
+			if err != nil {
+				ob.log.WithContext(ctx).Warnf("settle fare of order %d: %v", o.ID, err)
+			} else if price > 0 {
+				finalPrice = price
+			}
+		}
+		values["final_price"] = sql.NullInt64{Int64: finalPrice, Valid: true}
+		return nil
+	}, values)
+}
+
+// 顾客支付订单，支付金额需与实际费用一致
+func (ob *OrderBiz) PayOrder(ctx context.Context, id, customerID uint, amount int64) (*Order, error) {
+	return ob.transit(ctx, id, OrderEventPay, func(o *Order) error {
+		if o.CustomerID != customerID {
+			return ErrOrderForbidden
+		}
+		if !o.FinalPrice.Valid || o.FinalPrice.Int64 != amount {
+			return ErrOrderAmount
+		}
+		return nil
+	}, map[string]interface{}{
+		"paid_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// 校验订单是否属于该司机
+func driverCheck(driverID uint) func(*Order) error {
+	return func(o *Order) error {
+		if !o.DriverID.Valid || uint(o.DriverID.Int64) != driverID {
+			return ErrOrderForbidden
+		}
+		return nil
+	}
+}
+
+// 执行一次状态迁移
+// 一，获取订单；二，业务校验；三，状态机校验；四，基于原状态的条件更新
+func (ob *OrderBiz) transit(ctx context.Context, id uint, event string, check func(*Order) error, values map[string]interface{}) (*Order, error) {
+	order, err := ob.OI.GetOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if check != nil {
+		if err := check(order); err != nil {
+			return nil, err
+		}
+	}
+	next, err := NextOrderStatus(order.Status, event)
+	if err != nil {
+		return nil, err
+	}
+	values["status"] = next
+	if err := ob.OI.TransitOrder(ctx, id, order.Status, values); err != nil {
+		return nil, err
+	}
+	return ob.OI.GetOrder(ctx, id)
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"common/quote"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"order/internal/conf"
+)
+
+func TestNextOrderStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		event  string
+		want   string
+		ok     bool
+	}{
+		{OrderStatusCreated, OrderEventAccept, OrderStatusAccepted, true},
+		{OrderStatusCreated, OrderEventCancel, OrderStatusCanceled, true},
+		{OrderStatusAccepted, OrderEventArrive, OrderStatusArrived, true},
+		{OrderStatusAccepted, OrderEventCancel, OrderStatusCanceled, true},
+		{OrderStatusArrived, OrderEventStart, OrderStatusStarted, true},
+		{OrderStatusArrived, OrderEventCancel, OrderStatusCanceled, true},
+		{OrderStatusStarted, OrderEventFinish, OrderStatusFinished, true},
+		{OrderStatusFinished, OrderEventPay, OrderStatusPaid, true},
+		// 非法迁移
+		{OrderStatusCreated, OrderEventStart, "", false},
+		{OrderStatusCreated, OrderEventPay, "", false},
+		{OrderStatusStarted, OrderEventCancel, "", false},
+		{OrderStatusFinished, OrderEventCancel, "", false},
+		{OrderStatusPaid, OrderEventPay, "", false},
+		{OrderStatusCanceled, OrderEventAccept, "", false},
+		{"unknown", OrderEventAccept, "", false},
+		{OrderStatusCreated, "unknown", "", false},
+	}
+	for _, tt := range tests {
+		got, err := NextOrderStatus(tt.status, tt.event)
+		if tt.ok {
+			if err != nil || got != tt.want {
+				t.Errorf("NextOrderStatus(%q, %q) = %q, %v, want %q", tt.status, tt.event, got, err, tt.want)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrOrderStatus) {
+			t.Errorf("NextOrderStatus(%q, %q) error = %v, want ErrOrderStatus", tt.status, tt.event, err)
+		}
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		lng      float64
+		lat      float64
+		ok       bool
+	}{
+		{"116.481028,39.989643", 116.481028, 39.989643, true},
+		{" 116.4 , 39.9 ", 116.4, 39.9, true},
+		{"-180,-90", -180, -90, true},
+		{"180.1,39.9", 0, 0, false},
+		{"116.4,90.1", 0, 0, false},
+		{"116.4", 0, 0, false},
+		{"116.4,39.9,1", 0, 0, false},
+		{"abc,39.9", 0, 0, false},
+		{"NaN,NaN", 0, 0, false},
+		{"116.4,nan", 0, 0, false},
+		{"Inf,39.9", 0, 0, false},
+		{"116.4,-Inf", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		lng, lat, err := ParseLocation(tt.location)
+		if tt.ok != (err == nil) || lng != tt.lng || lat != tt.lat {
+			t.Errorf("ParseLocation(%q) = %v, %v, %v", tt.location, lng, lat, err)
+		}
+	}
+}
+
+// 只实现 CreateOrder 的 OrderInterface，记录创建的订单
+type fakeOrderRepo struct {
+	OrderInterface
+	created *Order
+}
+
+func (f *fakeOrderRepo) CreateOrder(ctx context.Context, order *Order) error {
+	f.created = order
+	return nil
+}
+
+func TestCreateOrder(t *testing.T) {
+	const secret, issuer = "test-quote-secret-0123456789abcdef", "LaoMaDJ-Valuation"
+	const origin, destination = "116.481028,39.989643", "116.434446,39.90816"
+	sign := func(secret string, price int64, exp time.Duration) string {
+		token, err := quote.Sign(&quote.Claims{
+			Origin:      origin,
+			Destination: destination,
+			City:        "110000",
+			Price:       price,
+			Surge:       1.5,
+			Tolls:       1000,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    issuer,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(exp)),
+				ID:        "quote-1",
+			},
+		}, secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	tests := []struct {
+		name        string
+		destination string
+		token       string
+		wantErr     error
+	}{
+		{"按报价的价格下单", destination, sign(secret, 4200, time.Minute), nil},
+		{"其他密钥签发", destination, sign("other-quote-secret-0123456789abcdef", 1, time.Minute), ErrQuoteInvalid},
+		{"报价已过期", destination, sign(secret, 4200, -time.Minute), ErrQuoteExpired},
+		{"终点与报价不一致", "116.4,39.9", sign(secret, 4200, time.Minute), ErrQuoteMismatch},
+		{"没有报价", destination, "", ErrQuoteInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOrderRepo{}
+			ob := NewOrderBiz(repo, nil, nil, &conf.Quote{Secret: secret, Issuer: issuer}, log.DefaultLogger)
+			order, err := ob.CreateOrder(context.Background(), 1, origin, tt.destination, tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || repo.created != nil {
+					t.Fatalf("CreateOrder error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateOrder error = %v", err)
+			}
+			if order.Price != 4200 || order.City != "110000" || order.Status != OrderStatusCreated ||
+				order.QuoteID != "quote-1" || order.SurgeMultiplier != 1.5 || order.Tolls != 1000 {
+				t.Errorf("CreateOrder = %+v", order.OrderWork)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"order/internal/biz"
+	"order/internal/conf"
+)
+
+// ProviderSet is data providers.
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewOrderInterface, NewOfferInterface, NewDispatchInterface, NewSettleInterface,
+	NewClientFactory, NewDriverClient, NewMapServiceClient, NewValuationClient)
+
+// Data .
+type Data struct {
+	// 操作MySQL的客户端
+	Mdb *gorm.DB
+	// 操作Redis的客户端
+	Rdb *redis.Client
+}
+
+// NewData .
+func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	data := &Data{}
+	// 连接redis，订单服务使用 3 号库
+	redisURL := fmt.Sprintf("redis://%s/3?dial_timeout=%d", c.Redis.Addr, 1)
+	options, err := redis.ParseURL(redisURL)
+	if err != nil {
+		data.Rdb = nil
+		log.Fatal(err)
+	}
+	data.Rdb = redis.NewClient(options)
+
+	// 初始Mdb
+	// 连接mysql，使用配置
+	dsn := c.Database.Source
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		data.Mdb = nil
+		log.Fatal(err)
+	}
+	data.Mdb = db
+	// 开发阶段，自动迁移表。发布阶段，表结构稳定，不需要migrate.
+	migrateTable(db)
+
+	cleanup := func() {
+		log.NewHelper(logger).Info("closing the data resources")
+		_ = data.Rdb.Close()
+	}
+	return data, cleanup, nil
+}
+
+func migrateTable(db *gorm.DB) {
+	// 自动迁移相关表
+	if err := db.AutoMigrate(&biz.Order{}); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"order/api/driver"
+	"order/api/mapService"
+	"order/api/valuation"
+	"order/internal/biz"
+	"strconv"
+)
+
+type DispatchData struct {
+	data *Data
+	dc   driver.DriverClient
+	mc   mapService.MapServiceClient
+	vc   valuation.ValuationClient
+}
+
+func NewDispatchInterface(data *Data, dc driver.DriverClient, mc mapService.MapServiceClient, vc valuation.ValuationClient) biz.DispatchInterface {
+	return &DispatchData{data: data, dc: dc, mc: mc, vc: vc}
+}
+
+// 附近的听单司机，通过 Driver 服务查询
+func (dd *DispatchData) NearbyDrivers(ctx context.Context, city string, lng, lat, radius float64, limit int) ([]*biz.Candidate, error) {
+	reply, err := dd.dc.NearbyDrivers(ctx, &driver.NearbyDriversReq{
+		City:   city,
+		Lng:    lng,
+		Lat:    lat,
+		Radius: radius,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*biz.Candidate, 0, len(reply.Drivers))
+	for _, d := range reply.Drivers {
+		candidates = append(candidates, &biz.Candidate{
+			DriverID: uint(d.DriverId),
+			Lng:      d.Lng,
+			Lat:      d.Lat,
+			Distance: int64(d.Distance),
+		})
+	}
+	return candidates, nil
+}
+
+// 多个起点到终点的驾驶距离和时长，通过 Map 服务的距离矩阵一次查询
+func (dd *DispatchData) DrivingETAs(ctx context.Context, origins []string, destination, city string) ([]*biz.ETA, error) {
+	reply, err := dd.mc.GetDistanceMatrix(ctx, &mapService.GetDistanceMatrixReq{
+		Origins:      origins,
+		Destinations: []string{destination},
+		City:         city,
+	})
+	if err != nil {
+		return nil, err
+	}
+	etas := make([]*biz.ETA, len(origins))
+	for i, row := range reply.Rows {
+		if i >= len(etas) || len(row.Cells) == 0 || row.Cells[0].Reason != "" {
+			continue
+		}
+		etas[i] = &biz.ETA{Distance: row.Cells[0].Distance, Duration: row.Cells[0].Duration}
+	}
+	return etas, nil
+}
+
+// 上报订单的需求信号，用于 Valuation 服务的动态调价
+func (dd *DispatchData) ReportDemand(ctx context.Context, order *biz.Order, open bool) error {
+	_, err := dd.vc.ReportSurgeSignal(ctx, &valuation.ReportSurgeSignalReq{
+		City:     order.City,
+		Location: order.Origin,
+		Kind:     "demand",
+		Member:   strconv.FormatUint(uint64(order.ID), 10),
+		Active:   open,
+	})
+	return err
+}
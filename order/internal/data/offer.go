@@ -0,0 +1,92 @@
+package data
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"order/internal/biz"
+	"strconv"
+	"time"
+)
+
+type OfferData struct {
+	data *Data
+}
+
+func NewOfferInterface(data *Data) biz.OfferInterface {
+	return &OfferData{data: data}
+}
+
+// 派给司机的订单，value 为订单id
+func offerKey(driverID uint) string {
+	return "OOFFER:" + strconv.FormatUint(uint64(driverID), 10)
+}
+
+// 订单的派单任务锁
+func dispatchKey(orderID uint) string {
+	return "ODSP:" + strconv.FormatUint(uint64(orderID), 10)
+}
+
+// 仅当 value 一致时才删除，避免释放了已被派给其他订单的锁
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// 派单，SETNX 保证司机同一时刻只持有一个派单
+func (fd *OfferData) LockOffer(ctx context.Context, driverID, orderID uint, ttl time.Duration) (bool, error) {
+	return fd.data.Rdb.SetNX(ctx, offerKey(driverID), orderID, ttl).Result()
+}
+
+// 获取派给司机的订单
+func (fd *OfferData) GetOffer(ctx context.Context, driverID uint) (uint, time.Time, error) {
+	key := offerKey(driverID)
+	var get *redis.StringCmd
+	var ttl *redis.DurationCmd
+	_, err := fd.data.Rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		ttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if err == redis.Nil {
+		return 0, time.Time{}, nil
+	}
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	orderID, err := get.Uint64()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return uint(orderID), time.Now().Add(ttl.Val()), nil
+}
+
+// 释放派单
+func (fd *OfferData) ReleaseOffer(ctx context.Context, driverID, orderID uint) error {
+	return releaseScript.Run(ctx, fd.data.Rdb, []string{offerKey(driverID)}, orderID).Err()
+}
+
+// 仅当 value 一致时才续期
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// 派单任务锁
+func (fd *OfferData) LockDispatch(ctx context.Context, orderID uint, token string, ttl time.Duration) (bool, error) {
+	return fd.data.Rdb.SetNX(ctx, dispatchKey(orderID), token, ttl).Result()
+}
+
+// 派单任务锁续期
+func (fd *OfferData) RenewDispatch(ctx context.Context, orderID uint, token string, ttl time.Duration) (bool, error) {
+	n, err := renewScript.Run(ctx, fd.data.Rdb, []string{dispatchKey(orderID)}, token, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+// 释放派单任务锁
+func (fd *OfferData) ReleaseDispatch(ctx context.Context, orderID uint, token string) error {
+	return releaseScript.Run(ctx, fd.data.Rdb, []string{dispatchKey(orderID)}, token).Err()
+}
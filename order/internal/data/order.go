@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"order/internal/biz"
+	"time"
+)
+
+type OrderData struct {
+	data *Data
+}
+
+func NewOrderInterface(data *Data) biz.OrderInterface {
+	return &OrderData{data: data}
+}
+
+// 创建订单
+func (od *OrderData) CreateOrder(ctx context.Context, order *biz.Order) error {
+	return od.data.Mdb.WithContext(ctx).Create(order).Error
+}
+
+// 获取订单
+func (od *OrderData) GetOrder(ctx context.Context, id uint) (*biz.Order, error) {
+	order := &biz.Order{}
+	if err := od.data.Mdb.WithContext(ctx).First(order, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrOrderNotFound
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// 状态迁移
+// 使用 status 作为条件更新（类似乐观锁），并发的两次迁移只会有一次成功
+func (od *OrderData) TransitOrder(ctx context.Context, id uint, from string, values map[string]interface{}) error {
+	result := od.data.Mdb.WithContext(ctx).Model(&biz.Order{}).
+		Where("id=? AND status=?", id, from).
+		Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrOrderConflict
+	}
+	return nil
+}
+
+// 分页查询订单
+func (od *OrderData) ListOrders(ctx context.Context, filter *biz.OrderFilter) ([]*biz.Order, int64, error) {
+	query := od.data.Mdb.WithContext(ctx).Model(&biz.Order{})
+	if filter.CustomerID != 0 {
+		query = query.Where("customer_id=?", filter.CustomerID)
+	}
+	if filter.DriverID != 0 {
+		query = query.Where("driver_id=?", filter.DriverID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status=?", filter.Status)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where("created_at>=?", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		query = query.Where("created_at<?", filter.CreatedTo)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	orders := []*biz.Order{}
+	if total == 0 {
+		return orders, 0, nil
+	}
+	if err := query.Order("id DESC").
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&orders).Error; err != nil {
+		return nil, 0, err
+	}
+	return orders, total, nil
+}
+
+// 从 ids 中筛选出有进行中订单的司机
+func (od *OrderData) BusyDrivers(ctx context.Context, ids []uint) (map[uint]struct{}, error) {
+	busy := map[uint]struct{}{}
+	if len(ids) == 0 {
+		return busy, nil
+	}
+	driverIDs := []uint{}
+	if err := od.data.Mdb.WithContext(ctx).Model(&biz.Order{}).
+		Where("driver_id IN ? AND status IN ?", ids, []string{biz.OrderStatusAccepted, biz.OrderStatusArrived, biz.OrderStatusStarted}).
+		Distinct().
+		Pluck("driver_id", &driverIDs).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range driverIDs {
+		busy[id] = struct{}{}
+	}
+	return busy, nil
+}
+
+// 在 before 之前下单且仍处于 status 状态的订单
+func (od *OrderData) StaleOrders(ctx context.Context, status string, before time.Time, limit int) ([]*biz.Order, error) {
+	orders := []*biz.Order{}
+	if err := od.data.Mdb.WithContext(ctx).
+		Where("status=? AND created_at<?", status, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"context"
+	"order/api/valuation"
+	"order/internal/biz"
+	"time"
+)
+
+type SettleData struct {
+	vc valuation.ValuationClient
+}
+
+func NewSettleInterface(vc valuation.ValuationClient) biz.SettleInterface {
+	return &SettleData{vc: vc}
+}
+
+// 通过 Valuation 服务结算，同一订单重复结算时返回已有的结算记录
+func (sd *SettleData) SettleFare(ctx context.Context, order *biz.Order, odometer int64, endedAt time.Time) (int64, error) {
+	req := &valuation.SettleFareReq{
+		OrderId:     uint64(order.ID),
+		City:        order.City,
+		Origin:      order.Origin,
+		Destination: order.Destination,
+		Odometer:    odometer,
+		EndedAt:     endedAt.Unix(),
+		QuotedPrice: order.Price,
+		// 顾客下单时看到的倍数，不受之后倍数变化的影响
+		SurgeMultiplier: order.SurgeMultiplier,
+		// 报价路线的过路费，作为附加费，不受报价上浮限制的影响
+		Tolls: order.Tolls,
+	}
+	if order.ArrivedAt.Valid {
+		req.ArrivedAt = order.ArrivedAt.Time.Unix()
+	}
+	if order.StartedAt.Valid {
+		req.StartedAt = order.StartedAt.Time.Unix()
+	}
+	reply, err := sd.vc.SettleFare(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return reply.Price, nil
+}
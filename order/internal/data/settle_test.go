@@ -0,0 +1,56 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"order/api/valuation"
+	"order/internal/biz"
+)
+
+// 只实现 SettleFare 的 Valuation 客户端，记录结算请求
+type fakeValuationClient struct {
+	valuation.ValuationClient
+	req *valuation.SettleFareReq
+}
+
+func (f *fakeValuationClient) SettleFare(ctx context.Context, in *valuation.SettleFareReq, opts ...grpc.CallOption) (*valuation.SettleFareReply, error) {
+	f.req = in
+	return &valuation.SettleFareReply{Price: in.QuotedPrice}, nil
+}
+
+func TestSettleFareQuote(t *testing.T) {
+	started := time.Date(2024, 6, 1, 22, 0, 0, 0, time.Local)
+	tests := []struct {
+		name  string
+		surge float64
+		tolls int64
+	}{
+		{"沿用报价的动态调价倍数", 1.5, 0},
+		{"报价没有调价", 1, 0},
+		{"沿用报价路线的过路费", 1.5, 1000},
+		{"旧订单没有报价", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := &fakeValuationClient{}
+			order := &biz.Order{}
+			order.ID = 7
+			order.City = "110000"
+			order.Price = 4200
+			order.SurgeMultiplier = tt.surge
+			order.Tolls = tt.tolls
+			order.StartedAt = sql.NullTime{Time: started, Valid: true}
+			price, err := NewSettleInterface(vc).SettleFare(context.Background(), order, 12000, started.Add(time.Hour))
+			if err != nil || price != 4200 {
+				t.Fatalf("SettleFare = %d, %v", price, err)
+			}
+			if vc.req.SurgeMultiplier != tt.surge || vc.req.Tolls != tt.tolls || vc.req.QuotedPrice != 4200 || vc.req.StartedAt != started.Unix() {
+				t.Errorf("SettleFareReq = %+v", vc.req)
+			}
+		})
+	}
+}
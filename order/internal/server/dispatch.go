@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"order/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 派单恢复任务，随应用启动和停止
+// 启动时立即扫描一次，之后每隔 biz.DispatchSweepInterval 扫描一次
+type DispatchServer struct {
+	db   *biz.DispatchBiz
+	log  *log.Helper
+	stop chan struct{}
+}
+
+func NewDispatchServer(db *biz.DispatchBiz, logger log.Logger) *DispatchServer {
+	return &DispatchServer{
+		db:   db,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+func (ds *DispatchServer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(biz.DispatchSweepInterval * time.Second)
+	defer ticker.Stop()
+	for {
+		if err := ds.db.Recover(ctx); err != nil {
+			ds.log.Errorf("recover dispatch: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ds.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (ds *DispatchServer) Stop(ctx context.Context) error {
+	close(ds.stop)
+	return nil
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"common/auth"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	v1 "order/api/helloworld/v1"
+	"order/api/order"
+	"order/internal/conf"
+	"order/internal/service"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewGRPCServer new a gRPC server.
+func NewGRPCServer(c *conf.Server, ac *conf.Auth, greeter *service.GreeterService, orderService *service.OrderService, logger log.Logger) *grpc.Server {
+	var opts = []grpc.ServerOption{
+		grpc.Middleware(
+			recovery.Recovery(),
+			tracing.Server(),
+			// 订单接口只由 Customer、Driver 服务调用，顾客和司机的身份由调用方认证，需要服务间调用的 token
+			selector.Server(auth.Service(ac.GetServiceSecret())).Prefix("/api.order.Order/").Build(),
+		),
+	}
+	if c.Grpc.Network != "" {
+		opts = append(opts, grpc.Network(c.Grpc.Network))
+	}
+	if c.Grpc.Addr != "" {
+		opts = append(opts, grpc.Address(c.Grpc.Addr))
+	}
+	if c.Grpc.Timeout != nil {
+		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
+	}
+	srv := grpc.NewServer(opts...)
+	v1.RegisterGreeterServer(srv, greeter)
+	order.RegisterOrderServer(srv, orderService)
+	return srv
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"order/internal/biz"
+	"time"
+
+	pb "order/api/order"
+)
+
+type OrderService struct {
+	pb.UnimplementedOrderServer
+	ob *biz.OrderBiz
+	db *biz.DispatchBiz
+}
+
+func NewOrderService(ob *biz.OrderBiz, db *biz.DispatchBiz) *OrderService {
+	return &OrderService{
+		ob: ob,
+		db: db,
+	}
+}
+
+// CreateOrder 创建订单，创建成功后开始派单
+func (s *OrderService) CreateOrder(ctx context.Context, req *pb.CreateOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.CreateOrder(ctx, uint(req.CustomerId), req.Origin, req.Destination, req.QuoteToken)
+	if err == nil {
+		s.db.Dispatch(order)
+	}
+	return orderReply(order, err)
+}
+
+// CancelOrder 取消订单
+func (s *OrderService) CancelOrder(ctx context.Context, req *pb.CancelOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.CancelOrder(ctx, uint(req.Id), uint(req.CustomerId), req.Reason)
+	return orderReply(order, err)
+}
+
+// AcceptOrder 司机接单
+func (s *OrderService) AcceptOrder(ctx context.Context, req *pb.AcceptOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.AcceptOrder(ctx, uint(req.Id), uint(req.DriverId))
+	return orderReply(order, err)
+}
+
+// ArriveOrder 司机到达起点
+func (s *OrderService) ArriveOrder(ctx context.Context, req *pb.ArriveOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.ArriveOrder(ctx, uint(req.Id), uint(req.DriverId))
+	return orderReply(order, err)
+}
+
+// StartOrder 开始代驾
+func (s *OrderService) StartOrder(ctx context.Context, req *pb.StartOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.StartOrder(ctx, uint(req.Id), uint(req.DriverId))
+	return orderReply(order, err)
+}
+
+// FinishOrder 结束代驾
+func (s *OrderService) FinishOrder(ctx context.Context, req *pb.FinishOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.FinishOrder(ctx, uint(req.Id), uint(req.DriverId), req.Odometer)
+	return orderReply(order, err)
+}
+
+// PayOrder 支付订单
+func (s *OrderService) PayOrder(ctx context.Context, req *pb.PayOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.PayOrder(ctx, uint(req.Id), uint(req.CustomerId), req.Amount)
+	return orderReply(order, err)
+}
+
+// GetOrder 获取订单详情
+func (s *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderReq) (*pb.OrderReply, error) {
+	order, err := s.ob.GetOrder(ctx, uint(req.Id))
+	return orderReply(order, err)
+}
+
+// ListOrders 订单列表
+func (s *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersReq) (*pb.ListOrdersReply, error) {
+	filter := &biz.OrderFilter{
+		CustomerID: uint(req.CustomerId),
+		DriverID:   uint(req.DriverId),
+		Status:     req.Status,
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+	}
+	if req.CreatedFrom > 0 {
+		filter.CreatedFrom = time.Unix(req.CreatedFrom, 0)
+	}
+	if req.CreatedTo > 0 {
+		filter.CreatedTo = time.Unix(req.CreatedTo, 0)
+	}
+	orders, total, err := s.ob.ListOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListOrdersReply{
+		Orders:   make([]*pb.OrderInfo, 0, len(orders)),
+		Total:    total,
+		Page:     int32(filter.Page),
+		PageSize: int32(filter.PageSize),
+	}
+	for _, order := range orders {
+		reply.Orders = append(reply.Orders, toOrderInfo(order))
+	}
+	return reply, nil
+}
+
+// GetOffer 获取派给司机的订单
+func (s *OrderService) GetOffer(ctx context.Context, req *pb.GetOfferReq) (*pb.OfferReply, error) {
+	order, expireAt, err := s.db.GetOffer(ctx, uint(req.DriverId))
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return &pb.OfferReply{}, nil
+	}
+	return &pb.OfferReply{
+		Order:    toOrderInfo(order),
+		ExpireAt: expireAt.Unix(),
+	}, nil
+}
+
+// 将订单模型转换为响应
+func orderReply(order *biz.Order, err error) (*pb.OrderReply, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OrderReply{
+		Order: toOrderInfo(order),
+	}, nil
+}
+
+// 订单模型 DO => DTO
+func toOrderInfo(order *biz.Order) *pb.OrderInfo {
+	info := &pb.OrderInfo{
+		Id:           uint64(order.ID),
+		CustomerId:   uint64(order.CustomerID),
+		DriverId:     uint64(order.DriverID.Int64),
+		Origin:       order.Origin,
+		Destination:  order.Destination,
+		City:         order.City,
+		Price:        order.Price,
+		FinalPrice:   order.FinalPrice.Int64,
+		Status:       order.Status,
+		CancelReason: order.CancelReason.String,
+		CreatedAt:    order.CreatedAt.Unix(),
+	}
+	if order.AcceptedAt.Valid {
+		info.AcceptedAt = order.AcceptedAt.Time.Unix()
+	}
+	if order.ArrivedAt.Valid {
+		info.ArrivedAt = order.ArrivedAt.Time.Unix()
+	}
+	if order.StartedAt.Valid {
+		info.StartedAt = order.StartedAt.Time.Unix()
+	}
+	if order.FinishedAt.Valid {
+		info.FinishedAt = order.FinishedAt.Time.Unix()
+	}
+	if order.PaidAt.Valid {
+		info.PaidAt = order.PaidAt.Time.Unix()
+	}
+	if order.CanceledAt.Valid {
+		info.CanceledAt = order.CanceledAt.Time.Unix()
+	}
+	return info
+}
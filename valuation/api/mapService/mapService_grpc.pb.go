@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	MapService_GetDrivingInfo_FullMethodName = "/api.mapService.MapService/GetDrivingInfo"
+	MapService_GetDrivingInfo_FullMethodName    = "/api.mapService.MapService/GetDrivingInfo"
+	MapService_GetDistanceMatrix_FullMethodName = "/api.mapService.MapService/GetDistanceMatrix"
+	MapService_Geocode_FullMethodName           = "/api.mapService.MapService/Geocode"
+	MapService_ReverseGeocode_FullMethodName    = "/api.mapService.MapService/ReverseGeocode"
+	MapService_PlaceSuggest_FullMethodName      = "/api.mapService.MapService/PlaceSuggest"
 )
 
 // MapServiceClient is the client API for MapService service.
@@ -27,6 +31,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MapServiceClient interface {
 	GetDrivingInfo(ctx context.Context, in *GetDrivingInfoReq, opts ...grpc.CallOption) (*GetDrivingReply, error)
+	// 距离矩阵，多个起点到多个终点的驾车距离和时长，例如候选司机到乘客的 ETA
+	GetDistanceMatrix(ctx context.Context, in *GetDistanceMatrixReq, opts ...grpc.CallOption) (*GetDistanceMatrixReply, error)
+	// 地理编码，地址转换为坐标
+	Geocode(ctx context.Context, in *GeocodeReq, opts ...grpc.CallOption) (*PlacesReply, error)
+	// 逆地理编码，坐标转换为地址
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeReq, opts ...grpc.CallOption) (*ReverseGeocodeReply, error)
+	// 输入提示，根据关键字返回候选地点
+	PlaceSuggest(ctx context.Context, in *PlaceSuggestReq, opts ...grpc.CallOption) (*PlacesReply, error)
 }
 
 type mapServiceClient struct {
@@ -47,11 +59,59 @@ func (c *mapServiceClient) GetDrivingInfo(ctx context.Context, in *GetDrivingInf
 	return out, nil
 }
 
+func (c *mapServiceClient) GetDistanceMatrix(ctx context.Context, in *GetDistanceMatrixReq, opts ...grpc.CallOption) (*GetDistanceMatrixReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDistanceMatrixReply)
+	err := c.cc.Invoke(ctx, MapService_GetDistanceMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapServiceClient) Geocode(ctx context.Context, in *GeocodeReq, opts ...grpc.CallOption) (*PlacesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacesReply)
+	err := c.cc.Invoke(ctx, MapService_Geocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapServiceClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeReq, opts ...grpc.CallOption) (*ReverseGeocodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseGeocodeReply)
+	err := c.cc.Invoke(ctx, MapService_ReverseGeocode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mapServiceClient) PlaceSuggest(ctx context.Context, in *PlaceSuggestReq, opts ...grpc.CallOption) (*PlacesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacesReply)
+	err := c.cc.Invoke(ctx, MapService_PlaceSuggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MapServiceServer is the server API for MapService service.
 // All implementations must embed UnimplementedMapServiceServer
 // for forward compatibility
 type MapServiceServer interface {
 	GetDrivingInfo(context.Context, *GetDrivingInfoReq) (*GetDrivingReply, error)
+	// 距离矩阵，多个起点到多个终点的驾车距离和时长，例如候选司机到乘客的 ETA
+	GetDistanceMatrix(context.Context, *GetDistanceMatrixReq) (*GetDistanceMatrixReply, error)
+	// 地理编码，地址转换为坐标
+	Geocode(context.Context, *GeocodeReq) (*PlacesReply, error)
+	// 逆地理编码，坐标转换为地址
+	ReverseGeocode(context.Context, *ReverseGeocodeReq) (*ReverseGeocodeReply, error)
+	// 输入提示，根据关键字返回候选地点
+	PlaceSuggest(context.Context, *PlaceSuggestReq) (*PlacesReply, error)
 	mustEmbedUnimplementedMapServiceServer()
 }
 
@@ -62,6 +122,18 @@ type UnimplementedMapServiceServer struct {
 func (UnimplementedMapServiceServer) GetDrivingInfo(context.Context, *GetDrivingInfoReq) (*GetDrivingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDrivingInfo not implemented")
 }
+func (UnimplementedMapServiceServer) GetDistanceMatrix(context.Context, *GetDistanceMatrixReq) (*GetDistanceMatrixReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDistanceMatrix not implemented")
+}
+func (UnimplementedMapServiceServer) Geocode(context.Context, *GeocodeReq) (*PlacesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Geocode not implemented")
+}
+func (UnimplementedMapServiceServer) ReverseGeocode(context.Context, *ReverseGeocodeReq) (*ReverseGeocodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
+func (UnimplementedMapServiceServer) PlaceSuggest(context.Context, *PlaceSuggestReq) (*PlacesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceSuggest not implemented")
+}
 func (UnimplementedMapServiceServer) mustEmbedUnimplementedMapServiceServer() {}
 
 // UnsafeMapServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +165,78 @@ func _MapService_GetDrivingInfo_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MapService_GetDistanceMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDistanceMatrixReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServiceServer).GetDistanceMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapService_GetDistanceMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServiceServer).GetDistanceMatrix(ctx, req.(*GetDistanceMatrixReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapService_Geocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeocodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServiceServer).Geocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapService_Geocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServiceServer).Geocode(ctx, req.(*GeocodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapService_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServiceServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapService_ReverseGeocode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServiceServer).ReverseGeocode(ctx, req.(*ReverseGeocodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MapService_PlaceSuggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceSuggestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapServiceServer).PlaceSuggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MapService_PlaceSuggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapServiceServer).PlaceSuggest(ctx, req.(*PlaceSuggestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MapService_ServiceDesc is the grpc.ServiceDesc for MapService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +248,22 @@ var MapService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDrivingInfo",
 			Handler:    _MapService_GetDrivingInfo_Handler,
 		},
+		{
+			MethodName: "GetDistanceMatrix",
+			Handler:    _MapService_GetDistanceMatrix_Handler,
+		},
+		{
+			MethodName: "Geocode",
+			Handler:    _MapService_Geocode_Handler,
+		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _MapService_ReverseGeocode_Handler,
+		},
+		{
+			MethodName: "PlaceSuggest",
+			Handler:    _MapService_PlaceSuggest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/mapService/mapService.proto",
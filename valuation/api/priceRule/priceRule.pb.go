@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.26.1
+// source: api/priceRule/priceRule.proto
+
+package priceRule
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 规则信息，金额均为最小货币单位，时间为 unix timestamp
+type PriceRuleInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId           uint64 `protobuf:"varint,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	StartAt          int32  `protobuf:"varint,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt            int32  `protobuf:"varint,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	StartFee         int64  `protobuf:"varint,5,opt,name=start_fee,json=startFee,proto3" json:"start_fee,omitempty"`
+	DistanceFee      int64  `protobuf:"varint,6,opt,name=distance_fee,json=distanceFee,proto3" json:"distance_fee,omitempty"`
+	DurationFee      int64  `protobuf:"varint,7,opt,name=duration_fee,json=durationFee,proto3" json:"duration_fee,omitempty"`
+	IncludedDistance int64  `protobuf:"varint,8,opt,name=included_distance,json=includedDistance,proto3" json:"included_distance,omitempty"`
+	MinFare          int64  `protobuf:"varint,9,opt,name=min_fare,json=minFare,proto3" json:"min_fare,omitempty"`
+	Currency         string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	EffectiveFrom    int64  `protobuf:"varint,11,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// 0 表示长期有效
+	EffectiveTo int64 `protobuf:"varint,12,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+}
+
+func (x *PriceRuleInfo) Reset() {
+	*x = PriceRuleInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRuleInfo) ProtoMessage() {}
+
+func (x *PriceRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRuleInfo.ProtoReflect.Descriptor instead.
+func (*PriceRuleInfo) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriceRuleInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetCityId() uint64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetStartAt() int32 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetEndAt() int32 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetStartFee() int64 {
+	if x != nil {
+		return x.StartFee
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetDistanceFee() int64 {
+	if x != nil {
+		return x.DistanceFee
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetDurationFee() int64 {
+	if x != nil {
+		return x.DurationFee
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetIncludedDistance() int64 {
+	if x != nil {
+		return x.IncludedDistance
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetMinFare() int64 {
+	if x != nil {
+		return x.MinFare
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceRuleInfo) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *PriceRuleInfo) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+// 版本中的一条规则
+type PriceRuleItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartAt          int32 `protobuf:"varint,1,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt            int32 `protobuf:"varint,2,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	StartFee         int64 `protobuf:"varint,3,opt,name=start_fee,json=startFee,proto3" json:"start_fee,omitempty"`
+	DistanceFee      int64 `protobuf:"varint,4,opt,name=distance_fee,json=distanceFee,proto3" json:"distance_fee,omitempty"`
+	DurationFee      int64 `protobuf:"varint,5,opt,name=duration_fee,json=durationFee,proto3" json:"duration_fee,omitempty"`
+	IncludedDistance int64 `protobuf:"varint,6,opt,name=included_distance,json=includedDistance,proto3" json:"included_distance,omitempty"`
+	MinFare          int64 `protobuf:"varint,7,opt,name=min_fare,json=minFare,proto3" json:"min_fare,omitempty"`
+}
+
+func (x *PriceRuleItem) Reset() {
+	*x = PriceRuleItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceRuleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRuleItem) ProtoMessage() {}
+
+func (x *PriceRuleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRuleItem.ProtoReflect.Descriptor instead.
+func (*PriceRuleItem) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PriceRuleItem) GetStartAt() int32 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetEndAt() int32 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetStartFee() int64 {
+	if x != nil {
+		return x.StartFee
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetDistanceFee() int64 {
+	if x != nil {
+		return x.DistanceFee
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetDurationFee() int64 {
+	if x != nil {
+		return x.DurationFee
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetIncludedDistance() int64 {
+	if x != nil {
+		return x.IncludedDistance
+	}
+	return 0
+}
+
+func (x *PriceRuleItem) GetMinFare() int64 {
+	if x != nil {
+		return x.MinFare
+	}
+	return 0
+}
+
+type ListPriceRulesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityId uint64 `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	At     int64  `protobuf:"varint,2,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *ListPriceRulesReq) Reset() {
+	*x = ListPriceRulesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceRulesReq) ProtoMessage() {}
+
+func (x *ListPriceRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceRulesReq.ProtoReflect.Descriptor instead.
+func (*ListPriceRulesReq) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPriceRulesReq) GetCityId() uint64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *ListPriceRulesReq) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+type ListPriceRulesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*PriceRuleInfo `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListPriceRulesReply) Reset() {
+	*x = ListPriceRulesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceRulesReply) ProtoMessage() {}
+
+func (x *ListPriceRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceRulesReply.ProtoReflect.Descriptor instead.
+func (*ListPriceRulesReply) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPriceRulesReply) GetRules() []*PriceRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetPriceRuleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPriceRuleReq) Reset() {
+	*x = GetPriceRuleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceRuleReq) ProtoMessage() {}
+
+func (x *GetPriceRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceRuleReq.ProtoReflect.Descriptor instead.
+func (*GetPriceRuleReq) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPriceRuleReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PriceRuleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *PriceRuleInfo `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *PriceRuleReply) Reset() {
+	*x = PriceRuleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRuleReply) ProtoMessage() {}
+
+func (x *PriceRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRuleReply.ProtoReflect.Descriptor instead.
+func (*PriceRuleReply) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PriceRuleReply) GetRule() *PriceRuleInfo {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreatePriceRulesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityId uint64 `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// 为空时使用 CNY
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// 为 0 时立即生效
+	EffectiveFrom int64 `protobuf:"varint,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// 为 0 时长期有效
+	EffectiveTo int64            `protobuf:"varint,4,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	Rules       []*PriceRuleItem `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *CreatePriceRulesReq) Reset() {
+	*x = CreatePriceRulesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePriceRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceRulesReq) ProtoMessage() {}
+
+func (x *CreatePriceRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceRulesReq.ProtoReflect.Descriptor instead.
+func (*CreatePriceRulesReq) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePriceRulesReq) GetCityId() uint64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *CreatePriceRulesReq) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatePriceRulesReq) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *CreatePriceRulesReq) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+func (x *CreatePriceRulesReq) GetRules() []*PriceRuleItem {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type UpdatePriceRuleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartFee         int64  `protobuf:"varint,2,opt,name=start_fee,json=startFee,proto3" json:"start_fee,omitempty"`
+	DistanceFee      int64  `protobuf:"varint,3,opt,name=distance_fee,json=distanceFee,proto3" json:"distance_fee,omitempty"`
+	DurationFee      int64  `protobuf:"varint,4,opt,name=duration_fee,json=durationFee,proto3" json:"duration_fee,omitempty"`
+	IncludedDistance int64  `protobuf:"varint,5,opt,name=included_distance,json=includedDistance,proto3" json:"included_distance,omitempty"`
+	MinFare          int64  `protobuf:"varint,6,opt,name=min_fare,json=minFare,proto3" json:"min_fare,omitempty"`
+}
+
+func (x *UpdatePriceRuleReq) Reset() {
+	*x = UpdatePriceRuleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceRuleReq) ProtoMessage() {}
+
+func (x *UpdatePriceRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceRuleReq.ProtoReflect.Descriptor instead.
+func (*UpdatePriceRuleReq) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePriceRuleReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleReq) GetStartFee() int64 {
+	if x != nil {
+		return x.StartFee
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleReq) GetDistanceFee() int64 {
+	if x != nil {
+		return x.DistanceFee
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleReq) GetDurationFee() int64 {
+	if x != nil {
+		return x.DurationFee
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleReq) GetIncludedDistance() int64 {
+	if x != nil {
+		return x.IncludedDistance
+	}
+	return 0
+}
+
+func (x *UpdatePriceRuleReq) GetMinFare() int64 {
+	if x != nil {
+		return x.MinFare
+	}
+	return 0
+}
+
+type DeletePriceRulesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityId        uint64 `protobuf:"varint,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	EffectiveFrom int64  `protobuf:"varint,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+}
+
+func (x *DeletePriceRulesReq) Reset() {
+	*x = DeletePriceRulesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRulesReq) ProtoMessage() {}
+
+func (x *DeletePriceRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRulesReq.ProtoReflect.Descriptor instead.
+func (*DeletePriceRulesReq) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePriceRulesReq) GetCityId() uint64 {
+	if x != nil {
+		return x.CityId
+	}
+	return 0
+}
+
+func (x *DeletePriceRulesReq) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+type DeletePriceRulesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeletePriceRulesReply) Reset() {
+	*x = DeletePriceRulesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_priceRule_priceRule_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRulesReply) ProtoMessage() {}
+
+func (x *DeletePriceRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_priceRule_priceRule_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRulesReply.ProtoReflect.Descriptor instead.
+func (*DeletePriceRulesReply) Descriptor() ([]byte, []int) {
+	return file_api_priceRule_priceRule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePriceRulesReply) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+var File_api_priceRule_priceRule_proto protoreflect.FileDescriptor
+
+var file_api_priceRule_priceRule_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xfb,
+	0x02, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x69, 0x6e, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x46, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x22, 0xec, 0x01, 0x0a,
+	0x0d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x46, 0x61, 0x72, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x61, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x32, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x54, 0x6f, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6d, 0x69, 0x6e, 0x46, 0x61, 0x72, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22,
+	0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x32, 0xc1, 0x03, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x56, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5a, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x23, 0x5a, 0x21, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x3b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_priceRule_priceRule_proto_rawDescOnce sync.Once
+	file_api_priceRule_priceRule_proto_rawDescData = file_api_priceRule_priceRule_proto_rawDesc
+)
+
+func file_api_priceRule_priceRule_proto_rawDescGZIP() []byte {
+	file_api_priceRule_priceRule_proto_rawDescOnce.Do(func() {
+		file_api_priceRule_priceRule_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_priceRule_priceRule_proto_rawDescData)
+	})
+	return file_api_priceRule_priceRule_proto_rawDescData
+}
+
+var file_api_priceRule_priceRule_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_priceRule_priceRule_proto_goTypes = []any{
+	(*PriceRuleInfo)(nil),         // 0: api.priceRule.PriceRuleInfo
+	(*PriceRuleItem)(nil),         // 1: api.priceRule.PriceRuleItem
+	(*ListPriceRulesReq)(nil),     // 2: api.priceRule.ListPriceRulesReq
+	(*ListPriceRulesReply)(nil),   // 3: api.priceRule.ListPriceRulesReply
+	(*GetPriceRuleReq)(nil),       // 4: api.priceRule.GetPriceRuleReq
+	(*PriceRuleReply)(nil),        // 5: api.priceRule.PriceRuleReply
+	(*CreatePriceRulesReq)(nil),   // 6: api.priceRule.CreatePriceRulesReq
+	(*UpdatePriceRuleReq)(nil),    // 7: api.priceRule.UpdatePriceRuleReq
+	(*DeletePriceRulesReq)(nil),   // 8: api.priceRule.DeletePriceRulesReq
+	(*DeletePriceRulesReply)(nil), // 9: api.priceRule.DeletePriceRulesReply
+}
+var file_api_priceRule_priceRule_proto_depIdxs = []int32{
+	0, // 0: api.priceRule.ListPriceRulesReply.rules:type_name -> api.priceRule.PriceRuleInfo
+	0, // 1: api.priceRule.PriceRuleReply.rule:type_name -> api.priceRule.PriceRuleInfo
+	1, // 2: api.priceRule.CreatePriceRulesReq.rules:type_name -> api.priceRule.PriceRuleItem
+	2, // 3: api.priceRule.PriceRule.ListPriceRules:input_type -> api.priceRule.ListPriceRulesReq
+	4, // 4: api.priceRule.PriceRule.GetPriceRule:input_type -> api.priceRule.GetPriceRuleReq
+	6, // 5: api.priceRule.PriceRule.CreatePriceRules:input_type -> api.priceRule.CreatePriceRulesReq
+	7, // 6: api.priceRule.PriceRule.UpdatePriceRule:input_type -> api.priceRule.UpdatePriceRuleReq
+	8, // 7: api.priceRule.PriceRule.DeletePriceRules:input_type -> api.priceRule.DeletePriceRulesReq
+	3, // 8: api.priceRule.PriceRule.ListPriceRules:output_type -> api.priceRule.ListPriceRulesReply
+	5, // 9: api.priceRule.PriceRule.GetPriceRule:output_type -> api.priceRule.PriceRuleReply
+	3, // 10: api.priceRule.PriceRule.CreatePriceRules:output_type -> api.priceRule.ListPriceRulesReply
+	5, // 11: api.priceRule.PriceRule.UpdatePriceRule:output_type -> api.priceRule.PriceRuleReply
+	9, // 12: api.priceRule.PriceRule.DeletePriceRules:output_type -> api.priceRule.DeletePriceRulesReply
+	8, // [8:13] is the sub-list for method output_type
+	3, // [3:8] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_priceRule_priceRule_proto_init() }
+func file_api_priceRule_priceRule_proto_init() {
+	if File_api_priceRule_priceRule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_priceRule_priceRule_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceRuleInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceRuleItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceRulesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPriceRulesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPriceRuleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceRuleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePriceRulesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePriceRuleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePriceRulesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_priceRule_priceRule_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePriceRulesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_priceRule_priceRule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_priceRule_priceRule_proto_goTypes,
+		DependencyIndexes: file_api_priceRule_priceRule_proto_depIdxs,
+		MessageInfos:      file_api_priceRule_priceRule_proto_msgTypes,
+	}.Build()
+	File_api_priceRule_priceRule_proto = out.File
+	file_api_priceRule_priceRule_proto_rawDesc = nil
+	file_api_priceRule_priceRule_proto_goTypes = nil
+	file_api_priceRule_priceRule_proto_depIdxs = nil
+}
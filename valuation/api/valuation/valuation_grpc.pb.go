@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Valuation_GetEstimatePrice_FullMethodName = "/api.valuation.Valuation/GetEstimatePrice"
+	Valuation_GetEstimatePrice_FullMethodName   = "/api.valuation.Valuation/GetEstimatePrice"
+	Valuation_SettleFare_FullMethodName         = "/api.valuation.Valuation/SettleFare"
+	Valuation_ReportSurgeSignal_FullMethodName  = "/api.valuation.Valuation/ReportSurgeSignal"
+	Valuation_ReportSurgeSignals_FullMethodName = "/api.valuation.Valuation/ReportSurgeSignals"
 )
 
 // ValuationClient is the client API for Valuation service.
@@ -27,6 +30,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ValuationClient interface {
 	GetEstimatePrice(ctx context.Context, in *GetEstimatePriceReq, opts ...grpc.CallOption) (*GetEstimatePriceReply, error)
+	// 行程结束后按实际轨迹（或里程表距离）和起止时间结算，结算记录创建后不再修改
+	SettleFare(ctx context.Context, in *SettleFareReq, opts ...grpc.CallOption) (*SettleFareReply, error)
+	// 上报供需信号：未结束的订单（Order 服务）和听单司机的位置（Driver 服务）
+	ReportSurgeSignal(ctx context.Context, in *ReportSurgeSignalReq, opts ...grpc.CallOption) (*ReportSurgeSignalReply, error)
+	// 批量上报供需信号，Driver 服务汇总听单司机的位置后定时上报
+	ReportSurgeSignals(ctx context.Context, in *ReportSurgeSignalsReq, opts ...grpc.CallOption) (*ReportSurgeSignalsReply, error)
 }
 
 type valuationClient struct {
@@ -47,11 +56,47 @@ func (c *valuationClient) GetEstimatePrice(ctx context.Context, in *GetEstimateP
 	return out, nil
 }
 
+func (c *valuationClient) SettleFare(ctx context.Context, in *SettleFareReq, opts ...grpc.CallOption) (*SettleFareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleFareReply)
+	err := c.cc.Invoke(ctx, Valuation_SettleFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationClient) ReportSurgeSignal(ctx context.Context, in *ReportSurgeSignalReq, opts ...grpc.CallOption) (*ReportSurgeSignalReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSurgeSignalReply)
+	err := c.cc.Invoke(ctx, Valuation_ReportSurgeSignal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationClient) ReportSurgeSignals(ctx context.Context, in *ReportSurgeSignalsReq, opts ...grpc.CallOption) (*ReportSurgeSignalsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSurgeSignalsReply)
+	err := c.cc.Invoke(ctx, Valuation_ReportSurgeSignals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ValuationServer is the server API for Valuation service.
 // All implementations must embed UnimplementedValuationServer
 // for forward compatibility
 type ValuationServer interface {
 	GetEstimatePrice(context.Context, *GetEstimatePriceReq) (*GetEstimatePriceReply, error)
+	// 行程结束后按实际轨迹（或里程表距离）和起止时间结算，结算记录创建后不再修改
+	SettleFare(context.Context, *SettleFareReq) (*SettleFareReply, error)
+	// 上报供需信号：未结束的订单（Order 服务）和听单司机的位置（Driver 服务）
+	ReportSurgeSignal(context.Context, *ReportSurgeSignalReq) (*ReportSurgeSignalReply, error)
+	// 批量上报供需信号，Driver 服务汇总听单司机的位置后定时上报
+	ReportSurgeSignals(context.Context, *ReportSurgeSignalsReq) (*ReportSurgeSignalsReply, error)
 	mustEmbedUnimplementedValuationServer()
 }
 
@@ -62,6 +107,15 @@ type UnimplementedValuationServer struct {
 func (UnimplementedValuationServer) GetEstimatePrice(context.Context, *GetEstimatePriceReq) (*GetEstimatePriceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEstimatePrice not implemented")
 }
+func (UnimplementedValuationServer) SettleFare(context.Context, *SettleFareReq) (*SettleFareReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleFare not implemented")
+}
+func (UnimplementedValuationServer) ReportSurgeSignal(context.Context, *ReportSurgeSignalReq) (*ReportSurgeSignalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSurgeSignal not implemented")
+}
+func (UnimplementedValuationServer) ReportSurgeSignals(context.Context, *ReportSurgeSignalsReq) (*ReportSurgeSignalsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSurgeSignals not implemented")
+}
 func (UnimplementedValuationServer) mustEmbedUnimplementedValuationServer() {}
 
 // UnsafeValuationServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +147,60 @@ func _Valuation_GetEstimatePrice_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Valuation_SettleFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleFareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServer).SettleFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Valuation_SettleFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServer).SettleFare(ctx, req.(*SettleFareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valuation_ReportSurgeSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSurgeSignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServer).ReportSurgeSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Valuation_ReportSurgeSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServer).ReportSurgeSignal(ctx, req.(*ReportSurgeSignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valuation_ReportSurgeSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSurgeSignalsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServer).ReportSurgeSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Valuation_ReportSurgeSignals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServer).ReportSurgeSignals(ctx, req.(*ReportSurgeSignalsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Valuation_ServiceDesc is the grpc.ServiceDesc for Valuation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +212,18 @@ var Valuation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEstimatePrice",
 			Handler:    _Valuation_GetEstimatePrice_Handler,
 		},
+		{
+			MethodName: "SettleFare",
+			Handler:    _Valuation_SettleFare_Handler,
+		},
+		{
+			MethodName: "ReportSurgeSignal",
+			Handler:    _Valuation_ReportSurgeSignal_Handler,
+		},
+		{
+			MethodName: "ReportSurgeSignals",
+			Handler:    _Valuation_ReportSurgeSignals_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/valuation/valuation.proto",
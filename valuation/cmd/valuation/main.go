@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
 	"github.com/google/uuid"
 	"github.com/hashicorp/consul/api"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -24,6 +26,8 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
+	// 内嵌时区数据，按城市时区选择计价规则，运行环境中没有 zoneinfo 时也可以使用
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -42,10 +46,10 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(cs *conf.Service, logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 	// 一，获取consul客户端
 	consulConfig := api.DefaultConfig()
-	consulConfig.Address = "192.168.43.144:8500"
+	consulConfig.Address = cs.Consul.Address
 	consulClient, err := api.NewClient(consulConfig)
 	if err != nil {
 		log.Fatal(err)
@@ -53,8 +57,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 	// 二，获取consul注册管理器
 	reg := consul.New(consulClient)
 	// 初始化 TP
-	tracerURL := "http://192.168.43.144:14268/api/traces"
-	if err := initTracer(tracerURL); err != nil {
+	if err := initTracer(cs.Jaeger.Url); err != nil {
 		log.Error(err)
 	}
 	return kratos.New(
@@ -86,6 +89,8 @@ func main() {
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
+			// 环境变量，去掉 LAOMADJ_ 前缀后用于替换配置文件中的 ${NAME:default}
+			env.NewSource("LAOMADJ_"),
 		),
 	)
 	defer c.Close()
@@ -98,8 +103,12 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
+	// 密钥没有默认值，未配置或过短时拒绝启动
+	if err := checkSecrets(&bc); err != nil {
+		panic(err)
+	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Service, bc.Server, bc.Data, bc.Valuation, bc.Auth, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -138,3 +147,17 @@ func initTracer(url string) error {
 	otel.SetTracerProvider(tracerProvider)
 	return nil
 }
+
+// HS256 密钥的最小长度
+const secretLenMin = 32
+
+// 检查配置中的密钥
+func checkSecrets(bc *conf.Bootstrap) error {
+	if len(bc.GetAuth().GetServiceSecret()) < secretLenMin {
+		return fmt.Errorf("auth.service_secret must be at least %d characters, set LAOMADJ_SERVICE_SECRET", secretLenMin)
+	}
+	if len(bc.GetValuation().GetQuote().GetSecret()) < secretLenMin {
+		return fmt.Errorf("valuation.quote.secret must be at least %d characters, set LAOMADJ_QUOTE_SECRET", secretLenMin)
+	}
+	return nil
+}
@@ -18,12 +18,13 @@ import (
 
 import (
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, valuation *conf.Valuation, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -32,12 +33,32 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	priceRuleInterface := data.NewPriceRuleInterface(dataData)
-	valuationBiz := biz.NewValuationBiz(priceRuleInterface)
-	valuationService := service.NewValuationService(valuationBiz)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, valuationService, logger)
+	cityInterface := data.NewCityInterface(dataData)
+	clientFactory, cleanup2, err := data.NewClientFactory(confService, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	mapServiceClient, err := data.NewMapServiceClient(clientFactory)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	valuationBiz := biz.NewValuationBiz(priceRuleInterface, cityInterface, mapServiceClient)
+	fareRecordInterface := data.NewFareRecordInterface(dataData)
+	settlementBiz := biz.NewSettlementBiz(valuationBiz, fareRecordInterface, valuation)
+	surgeInterface := data.NewSurgeInterface(dataData)
+	surgeBiz := biz.NewSurgeBiz(surgeInterface, valuation, logger)
+	quoteBiz := biz.NewQuoteBiz(valuation)
+	valuationService := service.NewValuationService(valuationBiz, settlementBiz, surgeBiz, quoteBiz)
+	priceRuleBiz := biz.NewPriceRuleBiz(priceRuleInterface, cityInterface)
+	priceRuleService := service.NewPriceRuleService(priceRuleBiz)
+	grpcServer := server.NewGRPCServer(confServer, auth, greeterService, valuationService, priceRuleService, logger)
 	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	app := newApp(confService, logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
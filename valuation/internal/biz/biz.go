@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase, NewValuationBiz)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewValuationBiz, NewPriceRuleBiz, NewSettlementBiz, NewSurgeBiz, NewQuoteBiz)
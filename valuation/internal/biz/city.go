@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrValuationParam = errors.BadRequest("VALUATION_PARAM_ERROR", "origin and destination must be lng,lat")
+	ErrCityNotFound   = errors.NotFound("CITY_NOT_FOUND", "city is not in service")
+	ErrCityTimezone   = errors.InternalServer("CITY_TIMEZONE_ERROR", "city timezone is invalid")
+)
+
+// 城市表模型，使用矩形边界根据坐标确定城市
+type City struct {
+	gorm.Model
+	Name     string  `gorm:"type:varchar(64);" json:"name"`
+	Code     string  `gorm:"type:varchar(16);uniqueIndex;" json:"code"` // 城市编码（adcode），与订单、司机位置使用的编码一致
+	Timezone string  `gorm:"type:varchar(64);" json:"timezone"`         // IANA 时区，例如 Asia/Shanghai
+	MinLng   float64 `gorm:"" json:"min_lng"`
+	MinLat   float64 `gorm:"" json:"min_lat"`
+	MaxLng   float64 `gorm:"" json:"max_lng"`
+	MaxLat   float64 `gorm:"" json:"max_lat"`
+}
+
+// 城市相关的资源操作接口
+type CityInterface interface {
+	GetCity(ctx context.Context, id uint) (*City, error)
+	GetCityByCode(ctx context.Context, code string) (*City, error)
+	// 边界包含该坐标的城市，边界重叠时取面积最小的
+	GetCityByLocation(ctx context.Context, lng, lat float64) (*City, error)
+}
+
+// 确定计价的城市，code 为空时根据起点坐标确定
+func (vb *ValuationBiz) ResolveCity(ctx context.Context, code, origin string) (*City, error) {
+	code = strings.TrimSpace(code)
+	if code != "" {
+		return vb.ci.GetCityByCode(ctx, code)
+	}
+	lng, lat, err := ParseLocation(origin)
+	if err != nil {
+		return nil, err
+	}
+	return vb.ci.GetCityByLocation(ctx, lng, lat)
+}
+
+// 出发时间在城市时区中的小时，departureAt 为 0 时使用当前时间
+func (c *City) LocalHour(departureAt int64) (int, time.Time, error) {
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return 0, time.Time{}, ErrCityTimezone
+	}
+	departure := time.Now()
+	if departureAt > 0 {
+		departure = time.Unix(departureAt, 0)
+	}
+	departure = departure.In(loc)
+	return departure.Hour(), departure, nil
+}
+
+// 解析 lng,lat 格式的坐标
+func ParseLocation(location string) (lng, lat float64, err error) {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return 0, 0, ErrValuationParam
+	}
+	if lng, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err != nil {
+		return 0, 0, ErrValuationParam
+	}
+	if lat, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+		return 0, 0, ErrValuationParam
+	}
+	// ParseFloat 接受 NaN 和 Inf，NaN 与任何数比较都为 false，需要单独排除
+	if math.IsNaN(lng) || math.IsNaN(lat) || math.IsInf(lng, 0) || math.IsInf(lat, 0) ||
+		lng < -180 || lng > 180 || lat < -90 || lat > 90 {
+		return 0, 0, ErrValuationParam
+	}
+	return lng, lat, nil
+}
@@ -0,0 +1,32 @@
+package biz
+
+import "testing"
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		lng      float64
+		lat      float64
+		ok       bool
+	}{
+		{"116.481028,39.989643", 116.481028, 39.989643, true},
+		{" 116.4 , 39.9 ", 116.4, 39.9, true},
+		{"-180,-90", -180, -90, true},
+		{"180.1,39.9", 0, 0, false},
+		{"116.4,90.1", 0, 0, false},
+		{"116.4", 0, 0, false},
+		{"abc,39.9", 0, 0, false},
+		// NaN 会通过范围比较，Inf 超出范围，都需要拒绝
+		{"NaN,NaN", 0, 0, false},
+		{"116.4,nan", 0, 0, false},
+		{"Inf,39.9", 0, 0, false},
+		{"116.4,-Inf", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range tests {
+		lng, lat, err := ParseLocation(tt.location)
+		if tt.ok != (err == nil) || lng != tt.lng || lat != tt.lat {
+			t.Errorf("ParseLocation(%q) = %v, %v, %v", tt.location, lng, lat, err)
+		}
+	}
+}
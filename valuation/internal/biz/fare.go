@@ -0,0 +1,141 @@
+package biz
+
+import "math"
+
+// 附加费和优惠的编码
+const FareItemMinFare = "min_fare"   // 不足最低消费时补足的差额
+const FareItemWaiting = "waiting"    // 超出免费等候时长的等候费
+const FareItemQuoteCap = "quote_cap" // 结算价超出报价上浮上限的部分
+const FareItemSurge = "surge"        // 动态调价的加价
+const FareItemTolls = "tolls"        // 过路费
+
+// 货币的小数位数，未列出的货币按 2 位处理
+var currencyMinorUnits = map[string]int32{
+	"CNY": 2,
+	"HKD": 2,
+	"USD": 2,
+	"JPY": 0,
+}
+
+// 附加费或优惠
+type FareItem struct {
+	Code   string
+	Name   string
+	Amount int64 // 均为正数，优惠从总价中扣除
+}
+
+// 费用明细，金额均为最小货币单位
+type Fare struct {
+	Currency         string
+	MinorUnit        int32 // 货币的小数位数，CNY 为 2，即金额单位为分
+	RuleID           uint
+	Distance         int64 // 行驶距离，单位 m
+	Duration         int64 // 行驶时长，单位 second
+	StartFee         int64
+	IncludedDistance int64 // 起步费包含的里程，单位 m
+	BillableDistance int64 // 计费里程，单位 m
+	DistanceFee      int64
+	DurationFee      int64
+	Surcharges       []*FareItem
+	Discounts        []*FareItem
+	Total            int64
+	SurgeMultiplier  float64 // 动态调价倍数，未调价时为 1
+	// 结算时按规则时段拆分的行程，预估时为空
+	Segments []*FareSegment
+}
+
+// 基于规则计算费用
+// 里程费按超出起步里程的部分计算，不足 1 公里不计；时长费不足 1 分钟不计；
+// 总价不足最低消费时，以附加费的形式补足差额
+func CalcFare(rule *PriceRule, distance, duration int64) *Fare {
+	fare := newFare(rule, distance, duration)
+	if fare.Distance > rule.IncludedDistance {
+		fare.BillableDistance = fare.Distance - rule.IncludedDistance
+	}
+	fare.DistanceFee = rule.DistanceFee * (fare.BillableDistance / 1000)
+	fare.DurationFee = rule.DurationFee * (fare.Duration / 60)
+	fare.sum()
+	fare.applyMinFare(rule.MinFare)
+	return fare
+}
+
+// 使用规则的起步费、起步里程和货币初始化费用明细
+func newFare(rule *PriceRule, distance, duration int64) *Fare {
+	if distance < 0 {
+		distance = 0
+	}
+	if duration < 0 {
+		duration = 0
+	}
+	fare := &Fare{
+		Currency:         rule.Currency,
+		RuleID:           rule.ID,
+		Distance:         distance,
+		Duration:         duration,
+		StartFee:         rule.StartFee,
+		IncludedDistance: rule.IncludedDistance,
+		Surcharges:       []*FareItem{},
+		Discounts:        []*FareItem{},
+	}
+	if fare.Currency == "" {
+		fare.Currency = "CNY"
+	}
+	fare.SurgeMultiplier = 1
+	fare.MinorUnit = 2
+	if unit, ok := currencyMinorUnits[fare.Currency]; ok {
+		fare.MinorUnit = unit
+	}
+	return fare
+}
+
+// 不足最低消费时补足差额
+func (f *Fare) applyMinFare(minFare int64) {
+	if f.Total < minFare {
+		f.AddSurcharge(FareItemMinFare, "最低消费补足", minFare-f.Total)
+	}
+}
+
+// 按倍数加价，加价部分作为附加费，以当前总价为基数
+func (f *Fare) ApplySurge(multiplier float64) {
+	if multiplier <= 1 {
+		return
+	}
+	f.SurgeMultiplier = multiplier
+	if amount := int64(math.Round(float64(f.Total) * (multiplier - 1))); amount > 0 {
+		f.AddSurcharge(FareItemSurge, "动态调价", amount)
+	}
+}
+
+// 加上过路费，在动态调价之后，过路费不参与调价
+func (f *Fare) AddTolls(tolls int64) {
+	if tolls > 0 {
+		f.AddSurcharge(FareItemTolls, "过路费", tolls)
+	}
+}
+
+// 增加附加费
+func (f *Fare) AddSurcharge(code, name string, amount int64) {
+	f.Surcharges = append(f.Surcharges, &FareItem{Code: code, Name: name, Amount: amount})
+	f.sum()
+}
+
+// 增加优惠，总价最低为 0
+func (f *Fare) AddDiscount(code, name string, amount int64) {
+	f.Discounts = append(f.Discounts, &FareItem{Code: code, Name: name, Amount: amount})
+	f.sum()
+}
+
+// 重新计算总价
+func (f *Fare) sum() {
+	total := f.StartFee + f.DistanceFee + f.DurationFee
+	for _, item := range f.Surcharges {
+		total += item.Amount
+	}
+	for _, item := range f.Discounts {
+		total -= item.Amount
+	}
+	if total < 0 {
+		total = 0
+	}
+	f.Total = total
+}
@@ -0,0 +1,61 @@
+package biz
+
+import "testing"
+
+func TestCalcFare(t *testing.T) {
+	rule := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 1000, DistanceFee: 200, DurationFee: 50, IncludedDistance: 3000, MinFare: 1500}}
+	jpy := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 500, DistanceFee: 100, Currency: "JPY"}}
+	tests := []struct {
+		name      string
+		rule      *PriceRule
+		distance  int64
+		duration  int64
+		billable  int64
+		total     int64
+		minFare   int64
+		currency  string
+		minorUnit int32
+	}{
+		// 起步里程内，不足 1 分钟不计，补足最低消费
+		{"最低消费", rule, 2000, 59, 0, 1500, 500, "CNY", 2},
+		// 计费里程 7500m 按 7 公里计，时长 1250s 按 20 分钟计
+		{"里程和时长取整", rule, 10500, 1250, 7500, 3400, 0, "CNY", 2},
+		{"负数按 0 计算", rule, -1, -1, 0, 1500, 500, "CNY", 2},
+		{"其他货币", jpy, 2500, 0, 2500, 700, 0, "JPY", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare := CalcFare(tt.rule, tt.distance, tt.duration)
+			if fare.BillableDistance != tt.billable || fare.Total != tt.total {
+				t.Errorf("billable %d, total %d, want %d, %d", fare.BillableDistance, fare.Total, tt.billable, tt.total)
+			}
+			var minFare int64
+			for _, item := range fare.Surcharges {
+				if item.Code == FareItemMinFare {
+					minFare = item.Amount
+				}
+			}
+			if minFare != tt.minFare {
+				t.Errorf("min fare surcharge %d, want %d", minFare, tt.minFare)
+			}
+			if fare.Currency != tt.currency || fare.MinorUnit != tt.minorUnit || fare.SurgeMultiplier != 1 {
+				t.Errorf("currency %s/%d, surge %v", fare.Currency, fare.MinorUnit, fare.SurgeMultiplier)
+			}
+		})
+	}
+}
+
+func TestFareAdjustments(t *testing.T) {
+	rule := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 1000}}
+	fare := CalcFare(rule, 0, 0)
+	fare.ApplySurge(1.25)
+	fare.AddTolls(300)
+	// 调价以过路费之前的总价为基数
+	if fare.Total != 1550 {
+		t.Fatalf("total %d, want 1550", fare.Total)
+	}
+	fare.AddDiscount(FareItemQuoteCap, "超出报价部分减免", 2000)
+	if fare.Total != 0 {
+		t.Fatalf("total %d, want 0", fare.Total)
+	}
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 一天的小时数，规则的时段为 [start_at, end_at)
+const PriceRuleHours = 24
+
+// 默认货币
+const PriceRuleCurrencyDefault = "CNY"
+
+var (
+	ErrPriceRuleParam     = errors.BadRequest("PRICE_RULE_PARAM_ERROR", "price rule param error")
+	ErrPriceRuleHours     = errors.BadRequest("PRICE_RULE_HOURS_ERROR", "hour ranges must cover 0-24 without overlap or gap")
+	ErrPriceRuleEffective = errors.BadRequest("PRICE_RULE_EFFECTIVE_ERROR", "effective_from must be later than now and the latest version of the city")
+	ErrPriceRuleActive    = errors.Forbidden("PRICE_RULE_ACTIVE", "only price rules not yet in effect can be changed")
+	ErrPriceRuleVersion   = errors.Conflict("PRICE_RULE_VERSION_CONFLICT", "only the latest version can be deleted")
+)
+
+// 计价规则管理业务逻辑
+type PriceRuleBiz struct {
+	pri PriceRuleInterface
+	ci  CityInterface
+}
+
+// PriceRuleBiz 构造器
+func NewPriceRuleBiz(pri PriceRuleInterface, ci CityInterface) *PriceRuleBiz {
+	return &PriceRuleBiz{
+		pri: pri,
+		ci:  ci,
+	}
+}
+
+// 城市的规则，at 为零值时返回所有版本
+func (rb *PriceRuleBiz) ListRules(ctx context.Context, cityID uint, at time.Time) ([]*PriceRule, error) {
+	if cityID == 0 {
+		return nil, ErrPriceRuleParam
+	}
+	return rb.pri.ListRules(ctx, cityID, at)
+}
+
+func (rb *PriceRuleBiz) GetRule(ctx context.Context, id uint) (*PriceRule, error) {
+	if id == 0 {
+		return nil, ErrPriceRuleParam
+	}
+	return rb.pri.GetRuleByID(ctx, id)
+}
+
+// 新建版本
+// from 为零值时立即生效，to 为零值时长期有效
+func (rb *PriceRuleBiz) CreateRules(ctx context.Context, cityID uint, currency string, from, to time.Time, works []PriceRuleWork) ([]*PriceRule, error) {
+	if _, err := rb.ci.GetCity(ctx, cityID); err != nil {
+		return nil, err
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = PriceRuleCurrencyDefault
+	}
+	if len(currency) != 3 {
+		return nil, ErrPriceRuleParam
+	}
+	now := time.Now()
+	if from.IsZero() {
+		from = now.Truncate(time.Second)
+	}
+	// 已经开始的时间段内的订单可能已按旧规则计价，不能修改
+	if from.Before(now.Add(-time.Minute)) {
+		return nil, ErrPriceRuleEffective
+	}
+	if !to.IsZero() && !to.After(from) {
+		return nil, ErrPriceRuleParam
+	}
+	if err := CheckRuleHours(works); err != nil {
+		return nil, err
+	}
+	rules := make([]*PriceRule, 0, len(works))
+	for _, w := range works {
+		if err := checkRuleFees(&w); err != nil {
+			return nil, err
+		}
+		w.CityID = cityID
+		w.Currency = currency
+		w.EffectiveFrom = from
+		w.EffectiveTo = sql.NullTime{Time: to, Valid: !to.IsZero()}
+		rules = append(rules, &PriceRule{PriceRuleWork: w})
+	}
+	if err := rb.pri.CreateRules(ctx, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// 修改规则的费用，时段不能修改（需要删除版本后重建）
+func (rb *PriceRuleBiz) UpdateRuleFees(ctx context.Context, id uint, fees *PriceRuleWork) (*PriceRule, error) {
+	if err := checkRuleFees(fees); err != nil {
+		return nil, err
+	}
+	rule, err := rb.GetRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !rule.EffectiveFrom.After(time.Now()) {
+		return nil, ErrPriceRuleActive
+	}
+	rule.StartFee = fees.StartFee
+	rule.DistanceFee = fees.DistanceFee
+	rule.DurationFee = fees.DurationFee
+	rule.IncludedDistance = fees.IncludedDistance
+	rule.MinFare = fees.MinFare
+	if err := rb.pri.UpdateRuleFees(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// 删除尚未生效的版本
+func (rb *PriceRuleBiz) DeleteRules(ctx context.Context, cityID uint, from time.Time) (int64, error) {
+	if cityID == 0 || from.IsZero() {
+		return 0, ErrPriceRuleParam
+	}
+	if !from.After(time.Now()) {
+		return 0, ErrPriceRuleActive
+	}
+	return rb.pri.DeleteRules(ctx, cityID, from)
+}
+
+// 校验版本内规则的时段：覆盖 0-24 点，不重叠，不留空隙
+func CheckRuleHours(works []PriceRuleWork) error {
+	if len(works) == 0 {
+		return ErrPriceRuleHours
+	}
+	sorted := make([]PriceRuleWork, len(works))
+	copy(sorted, works)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].StartAt < sorted[j].StartAt
+	})
+	next := 0
+	for _, w := range sorted {
+		if w.StartAt != next || w.EndAt <= w.StartAt || w.EndAt > PriceRuleHours {
+			return ErrPriceRuleHours.WithMetadata(map[string]string{
+				"hour": strconv.Itoa(next),
+			})
+		}
+		next = w.EndAt
+	}
+	if next != PriceRuleHours {
+		return ErrPriceRuleHours.WithMetadata(map[string]string{
+			"hour": strconv.Itoa(next),
+		})
+	}
+	return nil
+}
+
+// 费用均不能为负数
+func checkRuleFees(w *PriceRuleWork) error {
+	if w.StartFee < 0 || w.DistanceFee < 0 || w.DurationFee < 0 || w.IncludedDistance < 0 || w.MinFare < 0 {
+		return ErrPriceRuleParam
+	}
+	return nil
+}
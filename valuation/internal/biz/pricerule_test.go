@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCheckRuleHours(t *testing.T) {
+	hours := func(ranges ...[2]int) []PriceRuleWork {
+		works := make([]PriceRuleWork, 0, len(ranges))
+		for _, r := range ranges {
+			works = append(works, PriceRuleWork{StartAt: r[0], EndAt: r[1]})
+		}
+		return works
+	}
+	tests := []struct {
+		name  string
+		works []PriceRuleWork
+		ok    bool
+		hour  string
+	}{
+		{"全天一条", hours([2]int{0, 24}), true, ""},
+		{"多个时段", hours([2]int{0, 7}, [2]int{7, 23}, [2]int{23, 24}), true, ""},
+		{"乱序", hours([2]int{23, 24}, [2]int{0, 7}, [2]int{7, 23}), true, ""},
+		{"为空", nil, false, ""},
+		{"不从 0 点开始", hours([2]int{1, 24}), false, "0"},
+		{"空隙", hours([2]int{0, 7}, [2]int{8, 24}), false, "7"},
+		{"重叠", hours([2]int{0, 8}, [2]int{7, 24}), false, "8"},
+		{"未到 24 点", hours([2]int{0, 7}, [2]int{7, 23}), false, "23"},
+		{"超过 24 点", hours([2]int{0, 25}), false, "0"},
+		{"空时段", hours([2]int{0, 0}, [2]int{0, 24}), false, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRuleHours(tt.works)
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("CheckRuleHours = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrPriceRuleHours) {
+				t.Fatalf("CheckRuleHours = %v, want ErrPriceRuleHours", err)
+			}
+			if tt.hour != "" && errors.FromError(err).Metadata["hour"] != tt.hour {
+				t.Fatalf("hour = %q, want %q", errors.FromError(err).Metadata["hour"], tt.hour)
+			}
+		})
+	}
+}
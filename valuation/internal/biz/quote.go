@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"common/quote"
+	"context"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"time"
+	"valuation/internal/conf"
+)
+
+// 报价有效期的默认值，配置缺失时使用
+const QuoteTTLDefault = 5 * time.Minute
+
+// 报价，有效期内下单时按报价的价格和调价倍数计价
+type Quote struct {
+	ID              string
+	Origin          string
+	Destination     string
+	City            string
+	Zone            string
+	RuleID          uint
+	RuleVersion     time.Time // 规则版本的生效时间
+	DepartureAt     time.Time
+	Price           int64
+	SurgeMultiplier float64
+	Tolls           int64 // 路线的过路费，已包含在价格中
+	Fare            *Fare
+	Strategy        string // 路线策略
+	Polyline        string // 计价使用的路线，不写入令牌
+	Approximate     bool   // 按估算的距离和时长计价
+	ExpiresAt       time.Time
+	Token           string
+}
+
+// 报价业务逻辑，签发报价令牌，不保存报价
+type QuoteBiz struct {
+	ttl    time.Duration
+	secret string
+	issuer string
+}
+
+// QuoteBiz 构造器
+func NewQuoteBiz(vc *conf.Valuation) *QuoteBiz {
+	ttl := vc.GetQuote().GetTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = QuoteTTLDefault
+	}
+	return &QuoteBiz{
+		ttl:    ttl,
+		secret: vc.GetQuote().GetSecret(),
+		issuer: vc.GetQuote().GetIssuer(),
+	}
+}
+
+// 签发报价令牌，生成报价id 和过期时间
+func (qb *QuoteBiz) CreateQuote(ctx context.Context, q *Quote) error {
+	now := time.Now()
+	q.ID = uuid.NewString()
+	q.ExpiresAt = now.Add(qb.ttl).Truncate(time.Second)
+	claims := &quote.Claims{
+		Origin:      q.Origin,
+		Destination: q.Destination,
+		City:        q.City,
+		Price:       q.Price,
+		Currency:    q.Fare.Currency,
+		RuleID:      q.RuleID,
+		RuleVersion: q.RuleVersion.Unix(),
+		Surge:       q.SurgeMultiplier,
+		Tolls:       q.Tolls,
+		Approximate: q.Approximate,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    qb.issuer,
+			ExpiresAt: jwt.NewNumericDate(q.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        q.ID,
+		},
+	}
+	// 使用HS256进行签名
+	token, err := quote.Sign(claims, qb.secret)
+	if err != nil {
+		return err
+	}
+	q.Token = token
+	return nil
+}
@@ -0,0 +1,386 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+	"valuation/internal/conf"
+)
+
+// 轨迹中相邻两点的最大速度，单位 m/s，超过时视为漂移点丢弃
+const SettleMaxSpeed = 55.0
+
+// 可以结算的最长行程，超过时视为异常数据
+const SettleTripMax = 24 * time.Hour
+
+// 地球平均半径，单位 m
+const earthRadius = 6371000.0
+
+var (
+	ErrSettleParam = errors.BadRequest("SETTLE_PARAM_ERROR", "order_id, started_at < ended_at and track or odometer are required")
+	ErrSettleRule  = errors.InternalServer("SETTLE_RULE_ERROR", "price rule hours are invalid")
+	ErrSettleSpan  = errors.BadRequest("SETTLE_SPAN_ERROR", "trip must not be longer than 24 hours")
+)
+
+// 轨迹点
+type TrackPoint struct {
+	Lng float64
+	Lat float64
+	At  time.Time
+}
+
+// 结束的行程
+type Trip struct {
+	OrderID     uint
+	City        string // 城市编码（adcode），为空时根据起点坐标确定
+	Origin      string
+	Destination string
+	Track       []TrackPoint
+	Odometer    int64     // 里程表距离，单位 m
+	ArrivedAt   time.Time // 司机到达起点的时间，零值表示不计等候
+	StartedAt   time.Time
+	EndedAt     time.Time
+	QuotedPrice int64   // 下单时的报价，0 表示不限制上浮
+	Surge       float64 // 下单时报价的动态调价倍数
+	Tolls       int64   // 过路费，下单时报价路线的过路费
+}
+
+// 按规则时段拆分的行程
+type FareSegment struct {
+	RuleID   uint
+	StartAt  time.Time
+	EndAt    time.Time
+	Distance int64 // 单位 m
+	Duration int64 // 单位 second
+}
+
+// 结算记录表模型，创建后不再修改，因此不使用 gorm.Model（没有更新和软删除）
+type FareRecord struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time `gorm:"" json:"created_at"`
+	OrderID     uint      `gorm:"uniqueIndex;" json:"order_id"`
+	CityID      uint      `gorm:"" json:"city_id"`
+	City        string    `gorm:"type:varchar(16);" json:"city"`
+	Currency    string    `gorm:"type:char(3);" json:"currency"`
+	QuotedPrice int64     `gorm:"" json:"quoted_price"`
+	Total       int64     `gorm:"" json:"total"`
+	Distance    int64     `gorm:"" json:"distance"`
+	Duration    int64     `gorm:"" json:"duration"`
+	Waiting     int64     `gorm:"" json:"waiting"` // 等候时长，单位 second
+	StartedAt   time.Time `gorm:"" json:"started_at"`
+	EndedAt     time.Time `gorm:"" json:"ended_at"`
+	Breakdown   string    `gorm:"type:text;" json:"breakdown"` // 费用明细 Fare 的 JSON
+	// 解析后的费用明细，不入库
+	Fare *Fare `gorm:"-" json:"-"`
+}
+
+// 结算记录相关的资源操作接口
+type FareRecordInterface interface {
+	// 保存结算记录，订单已结算时不保存，返回已有的记录，created 为 false
+	CreateFareRecord(ctx context.Context, record *FareRecord) (saved *FareRecord, created bool, err error)
+}
+
+// 结算业务逻辑
+type SettlementBiz struct {
+	vb          *ValuationBiz
+	fri         FareRecordInterface
+	tolerance   float64
+	freeWaiting int64
+}
+
+// SettlementBiz 构造器
+func NewSettlementBiz(vb *ValuationBiz, fri FareRecordInterface, vc *conf.Valuation) *SettlementBiz {
+	return &SettlementBiz{
+		vb:          vb,
+		fri:         fri,
+		tolerance:   vc.GetSettlement().GetTolerance(),
+		freeWaiting: int64(vc.GetSettlement().GetFreeWaiting().AsDuration().Seconds()),
+	}
+}
+
+// 结算行程
+// 一，按城市时区和规则时段拆分行程；二，将距离按时间分配到各时段；三，计算费用并限制相对报价的上浮；
+// 四，保存结算记录。订单已结算时返回已有的记录
+func (sb *SettlementBiz) SettleFare(ctx context.Context, trip *Trip) (*FareRecord, bool, error) {
+	if trip.OrderID == 0 || trip.StartedAt.IsZero() || !trip.EndedAt.After(trip.StartedAt) ||
+		len(trip.Track) == 0 && trip.Odometer <= 0 || trip.Odometer < 0 || trip.QuotedPrice < 0 || trip.Tolls < 0 {
+		return nil, false, ErrSettleParam
+	}
+	if trip.EndedAt.Sub(trip.StartedAt) > SettleTripMax {
+		return nil, false, ErrSettleSpan
+	}
+	origin := trip.Origin
+	if origin == "" && len(trip.Track) > 0 {
+		origin = strconv.FormatFloat(trip.Track[0].Lng, 'f', 6, 64) + "," + strconv.FormatFloat(trip.Track[0].Lat, 'f', 6, 64)
+	}
+	city, err := sb.vb.ResolveCity(ctx, trip.City, origin)
+	if err != nil {
+		return nil, false, err
+	}
+	rules, segments, err := sb.vb.SplitTrip(ctx, city, trip.StartedAt, trip.EndedAt)
+	if err != nil {
+		return nil, false, err
+	}
+	allocateDistance(segments, trip.Track, trip.Odometer, trip.StartedAt, trip.EndedAt)
+	var waiting int64
+	if !trip.ArrivedAt.IsZero() && trip.ArrivedAt.Before(trip.StartedAt) {
+		waiting = trip.StartedAt.Unix() - trip.ArrivedAt.Unix()
+	}
+	fare := SettleSegments(rules, segments, waiting-sb.freeWaiting, trip.Surge)
+	fare.AddTolls(trip.Tolls)
+	// 报价包含报价路线的过路费，上浮限制只比较不含过路费的部分
+	sb.capFare(fare, trip.QuotedPrice-trip.Tolls)
+	breakdown, err := json.Marshal(fare)
+	if err != nil {
+		return nil, false, err
+	}
+	record, created, err := sb.fri.CreateFareRecord(ctx, &FareRecord{
+		OrderID:     trip.OrderID,
+		CityID:      city.ID,
+		City:        city.Code,
+		Currency:    fare.Currency,
+		QuotedPrice: trip.QuotedPrice,
+		Total:       fare.Total,
+		Distance:    fare.Distance,
+		Duration:    fare.Duration,
+		Waiting:     waiting,
+		StartedAt:   trip.StartedAt,
+		EndedAt:     trip.EndedAt,
+		Breakdown:   string(breakdown),
+		Fare:        fare,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if record.Fare == nil {
+		record.Fare = &Fare{}
+		if err := json.Unmarshal([]byte(record.Breakdown), record.Fare); err != nil {
+			return nil, false, err
+		}
+	}
+	return record, created, nil
+}
+
+// 行程费用（不含等候费和过路费）超出报价（不含过路费）上浮上限时，以优惠的形式减免超出部分
+func (sb *SettlementBiz) capFare(fare *Fare, quotedPrice int64) {
+	if sb.tolerance <= 0 || quotedPrice <= 0 {
+		return
+	}
+	limit := int64(math.Floor(float64(quotedPrice) * (1 + sb.tolerance)))
+	total := fare.Total
+	for _, item := range fare.Surcharges {
+		if item.Code == FareItemWaiting || item.Code == FareItemTolls {
+			total -= item.Amount
+		}
+	}
+	if total > limit {
+		fare.AddDiscount(FareItemQuoteCap, "超出报价部分减免", total-limit)
+	}
+}
+
+// 按城市时区中规则的时段拆分行程，返回每段使用的规则
+// 行程期间生效过的规则一次查出，在内存中拆分
+func (vb *ValuationBiz) SplitTrip(ctx context.Context, city *City, start, end time.Time) ([]*PriceRule, []*FareSegment, error) {
+	if end.Sub(start) > SettleTripMax {
+		return nil, nil, ErrSettleSpan
+	}
+	loc, err := time.LoadLocation(city.Timezone)
+	if err != nil {
+		return nil, nil, ErrCityTimezone
+	}
+	rules, err := vb.pri.ListRulesBetween(ctx, city.ID, start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitTrip(rules, loc, start, end)
+}
+
+// 时段的边界为规则的 end_at 和版本的失效时间，相邻两段使用同一规则时合并
+func splitTrip(all []*PriceRule, loc *time.Location, start, end time.Time) ([]*PriceRule, []*FareSegment, error) {
+	rules := []*PriceRule{}
+	segments := []*FareSegment{}
+	for t := start; t.Before(end); {
+		local := t.In(loc)
+		rule := ruleAt(all, local.Hour(), t)
+		if rule == nil {
+			return nil, nil, ErrPriceRuleNotFound
+		}
+		// end_at 为 24 时，time.Date 会规范化为次日 0 点
+		next := time.Date(local.Year(), local.Month(), local.Day(), rule.EndAt, 0, 0, 0, loc)
+		if rule.EffectiveTo.Valid && rule.EffectiveTo.Time.Before(next) {
+			next = rule.EffectiveTo.Time
+		}
+		if next.After(end) {
+			next = end
+		}
+		if !next.After(t) {
+			return nil, nil, ErrSettleRule
+		}
+		if n := len(segments); n > 0 && rules[n-1].ID == rule.ID {
+			segments[n-1].EndAt = next
+			segments[n-1].Duration = next.Unix() - segments[n-1].StartAt.Unix()
+		} else {
+			rules = append(rules, rule)
+			segments = append(segments, &FareSegment{
+				RuleID:   rule.ID,
+				StartAt:  t,
+				EndAt:    next,
+				Duration: next.Unix() - t.Unix(),
+			})
+		}
+		t = next
+	}
+	return rules, segments, nil
+}
+
+// at 时刻生效的版本中 hour 所在时段的规则，与 PriceRuleInterface.GetRule 的条件相同
+func ruleAt(rules []*PriceRule, hour int, at time.Time) *PriceRule {
+	for _, rule := range rules {
+		if rule.EffectiveFrom.After(at) || rule.EffectiveTo.Valid && !rule.EffectiveTo.Time.After(at) {
+			continue
+		}
+		if rule.StartAt <= hour && rule.EndAt > hour {
+			return rule
+		}
+	}
+	return nil
+}
+
+// 基于各时段的规则计算费用
+// 起步费、起步里程和最低消费使用行程开始时的规则，起步里程从第一段开始扣除；
+// 里程费和时长费按各段的单价加权，取整方式与预估相同（不足 1 公里、1 分钟不计）；
+// 动态调价沿用下单时报价的倍数，不再按结算时的供需计算；
+// 超出免费等候时长的部分按开始时规则的时长费计收等候费，不参与调价
+func SettleSegments(rules []*PriceRule, segments []*FareSegment, waiting int64, surge float64) *Fare {
+	first := rules[0]
+	var distance, duration int64
+	for _, seg := range segments {
+		distance += seg.Distance
+		duration += seg.Duration
+	}
+	fare := newFare(first, distance, duration)
+	fare.Segments = segments
+	included := first.IncludedDistance
+	var distanceWeighted, durationWeighted int64
+	for i, seg := range segments {
+		billable := seg.Distance
+		if included > 0 {
+			deduct := billable
+			if deduct > included {
+				deduct = included
+			}
+			billable -= deduct
+			included -= deduct
+		}
+		fare.BillableDistance += billable
+		distanceWeighted += rules[i].DistanceFee * billable
+		durationWeighted += rules[i].DurationFee * seg.Duration
+	}
+	if fare.BillableDistance > 0 {
+		fare.DistanceFee = distanceWeighted * (fare.BillableDistance / 1000) / fare.BillableDistance
+	}
+	if fare.Duration > 0 {
+		fare.DurationFee = durationWeighted * (fare.Duration / 60) / fare.Duration
+	}
+	fare.sum()
+	fare.applyMinFare(first.MinFare)
+	fare.ApplySurge(surge)
+	if waiting >= 60 && first.DurationFee > 0 {
+		fare.AddSurcharge(FareItemWaiting, "等候费", first.DurationFee*(waiting/60))
+	}
+	return fare
+}
+
+// 将行程距离按时间分配到各时段
+// 轨迹中相邻两点间的距离按与各时段重叠的时长比例分配；同时提供里程表距离时，按里程表的总距离等比缩放；
+// 只有里程表距离时，按时长比例分配
+func allocateDistance(segments []*FareSegment, track []TrackPoint, odometer int64, start, end time.Time) {
+	type piece struct {
+		from, to time.Time
+		meters   float64
+	}
+	pieces := []piece{}
+	var total float64
+	points := cleanTrack(track, start, end)
+	for i := 1; i < len(points); i++ {
+		meters := haversine(points[i-1], points[i])
+		pieces = append(pieces, piece{from: points[i-1].At, to: points[i].At, meters: meters})
+		total += meters
+	}
+	if odometer > 0 {
+		if total > 0 {
+			scale := float64(odometer) / total
+			for i := range pieces {
+				pieces[i].meters *= scale
+			}
+		} else {
+			pieces = []piece{{from: start, to: end, meters: float64(odometer)}}
+		}
+	}
+	allocated := make([]float64, len(segments))
+	for _, p := range pieces {
+		span := p.to.Sub(p.from).Seconds()
+		for i, seg := range segments {
+			if span <= 0 {
+				// 同一时刻的两点，计入该时刻所在的时段
+				if !p.from.Before(seg.StartAt) && p.from.Before(seg.EndAt) || i == len(segments)-1 && !p.from.Before(seg.EndAt) {
+					allocated[i] += p.meters
+					break
+				}
+				continue
+			}
+			from, to := p.from, p.to
+			if from.Before(seg.StartAt) {
+				from = seg.StartAt
+			}
+			if to.After(seg.EndAt) {
+				to = seg.EndAt
+			}
+			if to.After(from) {
+				allocated[i] += p.meters * to.Sub(from).Seconds() / span
+			}
+		}
+	}
+	for i, seg := range segments {
+		seg.Distance = int64(math.Round(allocated[i]))
+	}
+}
+
+// 按时间排序，丢弃行程时间之外的点和速度超过 SettleMaxSpeed 的漂移点
+func cleanTrack(track []TrackPoint, start, end time.Time) []TrackPoint {
+	sorted := make([]TrackPoint, 0, len(track))
+	for _, p := range track {
+		if p.At.Before(start) || p.At.After(end) {
+			continue
+		}
+		sorted = append(sorted, p)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].At.Before(sorted[j].At)
+	})
+	points := make([]TrackPoint, 0, len(sorted))
+	for _, p := range sorted {
+		if n := len(points); n > 0 {
+			meters := haversine(points[n-1], p)
+			seconds := p.At.Sub(points[n-1].At).Seconds()
+			if meters > 0 && (seconds <= 0 || meters/seconds > SettleMaxSpeed) {
+				continue
+			}
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// 两点间的球面距离，单位 m
+func haversine(a, b TrackPoint) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 北京时间，不依赖系统的时区数据
+var testLoc = time.FixedZone("CST", 8*3600)
+
+func testRule(id uint, start, end int, from time.Time, to time.Time) *PriceRule {
+	rule := &PriceRule{}
+	rule.ID = id
+	rule.StartAt = start
+	rule.EndAt = end
+	rule.EffectiveFrom = from
+	rule.EffectiveTo = sql.NullTime{Time: to, Valid: !to.IsZero()}
+	return rule
+}
+
+func TestSplitTrip(t *testing.T) {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, 3, day, hour, min, 0, 0, testLoc)
+	}
+	legacy := time.Date(2000, 1, 1, 0, 0, 0, 0, testLoc)
+	// 默认的三个时段
+	night, day, late := testRule(1, 0, 7, legacy, time.Time{}), testRule(2, 7, 23, legacy, time.Time{}), testRule(3, 23, 24, legacy, time.Time{})
+	// 全天一条规则
+	allDay := testRule(4, 0, 24, legacy, time.Time{})
+	// 3 月 1 日 10:00 起生效的新版本，旧版本同时失效
+	oldDay := testRule(5, 7, 23, legacy, at(1, 10, 0))
+	oldNight := testRule(6, 0, 7, legacy, at(1, 10, 0))
+	newAllDay := testRule(7, 0, 24, at(1, 10, 0), time.Time{})
+
+	type seg struct {
+		rule     uint
+		duration int64
+	}
+	tests := []struct {
+		name       string
+		rules      []*PriceRule
+		start, end time.Time
+		want       []seg
+		err        *errors.Error
+	}{
+		{"同一时段", []*PriceRule{night, day, late}, at(1, 8, 0), at(1, 9, 0), []seg{{2, 3600}}, nil},
+		{"跨时段和日期", []*PriceRule{night, day, late}, at(1, 22, 50), at(2, 0, 20), []seg{{2, 600}, {3, 3600}, {1, 1200}}, nil},
+		{"跨日期的同一规则合并", []*PriceRule{allDay}, at(1, 22, 0), at(2, 2, 0), []seg{{4, 14400}}, nil},
+		{"行程中切换版本", []*PriceRule{oldNight, oldDay, newAllDay}, at(1, 9, 30), at(1, 10, 30), []seg{{5, 1800}, {7, 1800}}, nil},
+		{"没有规则", nil, at(1, 8, 0), at(1, 9, 0), nil, ErrPriceRuleNotFound},
+		{"时段有空隙", []*PriceRule{night, late}, at(1, 6, 0), at(1, 8, 0), nil, ErrPriceRuleNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, segments, err := splitTrip(tt.rules, testLoc, tt.start, tt.end)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("splitTrip error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(segments) != len(tt.want) || len(rules) != len(segments) {
+				t.Fatalf("got %d segments, %d rules, want %d", len(segments), len(rules), len(tt.want))
+			}
+			next := tt.start
+			for i, s := range segments {
+				if s.RuleID != tt.want[i].rule || rules[i].ID != tt.want[i].rule || s.Duration != tt.want[i].duration {
+					t.Errorf("segment %d: rule %d, duration %d, want %+v", i, s.RuleID, s.Duration, tt.want[i])
+				}
+				if !s.StartAt.Equal(next) {
+					t.Errorf("segment %d starts at %s, want %s", i, s.StartAt, next)
+				}
+				next = s.EndAt
+			}
+			if !next.Equal(tt.end) {
+				t.Errorf("last segment ends at %s, want %s", next, tt.end)
+			}
+		})
+	}
+}
+
+func TestSplitTripSpan(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, testLoc)
+	// 超过上限时在查询规则前返回
+	vb := &ValuationBiz{}
+	if _, _, err := vb.SplitTrip(context.Background(), &City{Timezone: "UTC"}, start, start.Add(SettleTripMax+time.Second)); !errors.Is(err, ErrSettleSpan) {
+		t.Fatalf("SplitTrip error = %v, want ErrSettleSpan", err)
+	}
+}
+
+func TestSettleSegments(t *testing.T) {
+	first := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 1000, DistanceFee: 200, DurationFee: 50, IncludedDistance: 3000}}
+	first.ID = 1
+	second := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 2000, DistanceFee: 300, DurationFee: 100, IncludedDistance: 5000}}
+	second.ID = 2
+	withMin := *first
+	withMin.MinFare = 8000
+	segments := func() []*FareSegment {
+		return []*FareSegment{
+			{RuleID: 1, Distance: 5000, Duration: 600},
+			{RuleID: 2, Distance: 4000, Duration: 1200},
+		}
+	}
+	tests := []struct {
+		name     string
+		first    *PriceRule
+		waiting  int64
+		surge    float64
+		billable int64
+		distance int64 // 里程费
+		duration int64 // 时长费
+		total    int64
+		items    map[string]int64
+	}{
+		// 起步里程从第一段扣除，计费里程 2000 + 4000，里程费 (200×2000 + 300×4000) × 6 / 6000
+		// 时长费 (50×600 + 100×1200) × 30 / 1800
+		{"加权单价", first, 0, 1, 6000, 1600, 2500, 5100, map[string]int64{}},
+		{"动态调价", first, 0, 1.5, 6000, 1600, 2500, 7650, map[string]int64{FareItemSurge: 2550}},
+		{"等候费", first, 300, 1, 6000, 1600, 2500, 5350, map[string]int64{FareItemWaiting: 250}},
+		{"等候不足 1 分钟", first, 59, 1, 6000, 1600, 2500, 5100, map[string]int64{}},
+		{"最低消费在调价之前补足", &withMin, 0, 1.5, 6000, 1600, 2500, 12000, map[string]int64{FareItemMinFare: 2900, FareItemSurge: 4000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare := SettleSegments([]*PriceRule{tt.first, second}, segments(), tt.waiting, tt.surge)
+			if fare.Distance != 9000 || fare.Duration != 1800 || fare.StartFee != tt.first.StartFee {
+				t.Errorf("distance %d, duration %d, start fee %d", fare.Distance, fare.Duration, fare.StartFee)
+			}
+			if fare.BillableDistance != tt.billable || fare.DistanceFee != tt.distance || fare.DurationFee != tt.duration || fare.Total != tt.total {
+				t.Errorf("billable %d, distance fee %d, duration fee %d, total %d, want %d %d %d %d",
+					fare.BillableDistance, fare.DistanceFee, fare.DurationFee, fare.Total, tt.billable, tt.distance, tt.duration, tt.total)
+			}
+			got := map[string]int64{}
+			for _, item := range fare.Surcharges {
+				got[item.Code] = item.Amount
+			}
+			if len(got) != len(tt.items) {
+				t.Errorf("surcharges %v, want %v", got, tt.items)
+			}
+			for code, amount := range tt.items {
+				if got[code] != amount {
+					t.Errorf("surcharge %s = %d, want %d", code, got[code], amount)
+				}
+			}
+		})
+	}
+}
+
+func TestAllocateDistance(t *testing.T) {
+	start := time.Date(2026, 3, 1, 22, 30, 0, 0, testLoc)
+	mid := start.Add(30 * time.Minute)
+	end := mid.Add(30 * time.Minute)
+	segments := func() []*FareSegment {
+		return []*FareSegment{{StartAt: start, EndAt: mid}, {StartAt: mid, EndAt: end}}
+	}
+	// 沿经线匀速行驶，每 10 分钟约 1112m
+	track := []TrackPoint{}
+	for i := 0; i <= 6; i++ {
+		track = append(track, TrackPoint{Lng: 116.4, Lat: 39.9 + 0.01*float64(i), At: start.Add(time.Duration(i) * 10 * time.Minute)})
+	}
+	// 漂移点：1 秒内移动 1km 以上
+	drift := TrackPoint{Lng: 116.5, Lat: 39.9, At: start.Add(time.Second)}
+	tests := []struct {
+		name     string
+		track    []TrackPoint
+		odometer int64
+		want     [2]int64
+	}{
+		{"只有里程表，按时长分配", nil, 9000, [2]int64{4500, 4500}},
+		{"轨迹按时间分配", track, 0, [2]int64{3336, 3336}},
+		{"轨迹按里程表缩放", track, 8000, [2]int64{4000, 4000}},
+		{"丢弃漂移点", append([]TrackPoint{drift}, track...), 0, [2]int64{3336, 3336}},
+	}
+	for _, tt := range tests {
+		segs := segments()
+		allocateDistance(segs, tt.track, tt.odometer, start, end)
+		for i := range segs {
+			if d := segs[i].Distance - tt.want[i]; d < -1 || d > 1 {
+				t.Errorf("%s: segment %d distance %d, want %d", tt.name, i, segs[i].Distance, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestCapFare(t *testing.T) {
+	rule := &PriceRule{PriceRuleWork: PriceRuleWork{StartFee: 1000, DistanceFee: 100}}
+	sb := &SettlementBiz{tolerance: 0.2}
+	tests := []struct {
+		name     string
+		distance int64 // 按公里计费，1000m 为 100
+		tolls    int64
+		quoted   int64 // 报价，包含报价路线的过路费
+		total    int64
+		discount int64
+	}{
+		{"未超出上浮上限", 30000, 0, 4000, 4000, 0},
+		{"超出部分减免", 50000, 0, 4000, 4800, 1200},
+		// 报价 5000 包含过路费 1000，行程费用以 4000 为报价计算上限 4800
+		{"过路费不放宽上限", 50000, 1000, 5000, 5800, 1200},
+		{"过路费不被减免", 30000, 1000, 5000, 5000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare := CalcFare(rule, tt.distance, 0)
+			fare.AddTolls(tt.tolls)
+			sb.capFare(fare, tt.quoted-tt.tolls)
+			var discount int64
+			for _, item := range fare.Discounts {
+				if item.Code == FareItemQuoteCap {
+					discount = item.Amount
+				}
+			}
+			if fare.Total != tt.total || discount != tt.discount {
+				t.Errorf("total %d, discount %d, want %d, %d", fare.Total, discount, tt.total, tt.discount)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"valuation/internal/conf"
+)
+
+// 供需信号的类型
+const SurgeKindDemand = "demand" // 未结束的订单
+const SurgeKindSupply = "supply" // 听单司机
+
+// 动态调价参数的默认值，配置缺失时使用
+const SurgePrecisionDefault = 6
+const SurgeDemandWindowDefault = 10 * time.Minute
+const SurgeSupplyWindowDefault = time.Minute
+const SurgeIntervalDefault = 30 * time.Second
+
+// 批量上报时单次的最大信号数
+const SurgeBatchMax = 500
+
+// geohash 的 base32 字符表
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+var ErrSurgeParam = errors.BadRequest("SURGE_PARAM_ERROR", "surge signal param error")
+var ErrSurgeBatch = errors.BadRequest("SURGE_BATCH_ERROR", "too many surge signals in one batch")
+
+// 城市编码，作为 redis key 的一部分
+var surgeCityPattern = regexp.MustCompile(`^\w{1,16}$`)
+
+// 区域的调价倍数
+type SurgeMultiplier struct {
+	Value     float64
+	UpdatedAt time.Time
+}
+
+// 动态调价相关的资源操作接口
+type SurgeInterface interface {
+	// 记录信号，active 为 false 时移除，window 为信号的有效期
+	SaveSignal(ctx context.Context, city, zone, kind, member string, active bool, window time.Duration) error
+	// 区域内 since 之后更新过的信号数
+	CountSignals(ctx context.Context, city, zone, kind string, since time.Time) (int64, error)
+	// 区域最近一次计算的倍数，没有时返回 nil
+	GetMultiplier(ctx context.Context, city, zone string) (*SurgeMultiplier, error)
+	SaveMultiplier(ctx context.Context, city, zone string, m *SurgeMultiplier, ttl time.Duration) error
+}
+
+// 动态调价业务逻辑
+type SurgeBiz struct {
+	si  SurgeInterface
+	cfg *conf.Valuation_Surge
+	log *log.Helper
+}
+
+// SurgeBiz 构造器
+func NewSurgeBiz(si SurgeInterface, vc *conf.Valuation, logger log.Logger) *SurgeBiz {
+	cfg := vc.GetSurge()
+	if cfg == nil {
+		cfg = &conf.Valuation_Surge{}
+	}
+	return &SurgeBiz{
+		si:  si,
+		cfg: cfg,
+		log: log.NewHelper(logger),
+	}
+}
+
+// 是否启用动态调价
+func (sb *SurgeBiz) Enabled() bool {
+	return sb.cfg.GetMax() > 1
+}
+
+// 位置所在的区域
+func (sb *SurgeBiz) Zone(location string) (string, error) {
+	lng, lat, err := ParseLocation(location)
+	if err != nil {
+		return "", err
+	}
+	precision := int(sb.cfg.GetPrecision())
+	if precision <= 0 {
+		precision = SurgePrecisionDefault
+	}
+	return Geohash(lng, lat, precision), nil
+}
+
+// 记录供需信号，返回位置所在的区域
+func (sb *SurgeBiz) ReportSignal(ctx context.Context, city, location, kind, member string, active bool) (string, error) {
+	city, member = strings.TrimSpace(city), strings.TrimSpace(member)
+	if !surgeCityPattern.MatchString(city) || member == "" || kind != SurgeKindDemand && kind != SurgeKindSupply {
+		return "", ErrSurgeParam
+	}
+	zone, err := sb.Zone(location)
+	if err != nil {
+		return "", err
+	}
+	if err := sb.si.SaveSignal(ctx, city, zone, kind, member, active, sb.window(kind)); err != nil {
+		return "", err
+	}
+	return zone, nil
+}
+
+// 批量上报的一个信号
+type SurgeSignal struct {
+	City     string
+	Location string
+	Kind     string
+	Member   string
+	Active   bool
+}
+
+// 批量记录供需信号，参数错误的信号被忽略，返回记录成功的信号数
+func (sb *SurgeBiz) ReportSignals(ctx context.Context, signals []*SurgeSignal) (int, error) {
+	if len(signals) > SurgeBatchMax {
+		return 0, ErrSurgeBatch
+	}
+	accepted := 0
+	for _, s := range signals {
+		_, err := sb.ReportSignal(ctx, s.City, s.Location, s.Kind, s.Member, s.Active)
+		if errors.IsBadRequest(err) {
+			continue
+		}
+		if err != nil {
+			return accepted, err
+		}
+		accepted++
+	}
+	return accepted, nil
+}
+
+// 区域当前的调价倍数，未启用或出错时为 1，不影响报价
+// 距上次计算不足 interval 时直接使用上次的倍数，否则按供需计算后与上次的倍数做指数平滑
+func (sb *SurgeBiz) Multiplier(ctx context.Context, city, zone string) float64 {
+	if !sb.Enabled() {
+		return 1
+	}
+	now := time.Now()
+	interval := sb.cfg.GetInterval().AsDuration()
+	if interval <= 0 {
+		interval = SurgeIntervalDefault
+	}
+	prev, err := sb.si.GetMultiplier(ctx, city, zone)
+	if err != nil {
+		sb.log.Warnf("get surge multiplier %s %s: %v", city, zone, err)
+		return 1
+	}
+	if prev != nil && now.Sub(prev.UpdatedAt) < interval {
+		return prev.Value
+	}
+	demand, err := sb.si.CountSignals(ctx, city, zone, SurgeKindDemand, now.Add(-sb.window(SurgeKindDemand)))
+	if err != nil {
+		sb.log.Warnf("count surge demand %s %s: %v", city, zone, err)
+		return 1
+	}
+	supply, err := sb.si.CountSignals(ctx, city, zone, SurgeKindSupply, now.Add(-sb.window(SurgeKindSupply)))
+	if err != nil {
+		sb.log.Warnf("count surge supply %s %s: %v", city, zone, err)
+		return 1
+	}
+	previous := 1.0
+	if prev != nil {
+		previous = prev.Value
+	}
+	m := SmoothMultiplier(previous, sb.RawMultiplier(demand, supply), sb.cfg.GetSmoothing())
+	// 倍数长时间未更新时，下次重新从供需计算，过期时间取统计窗口
+	if err := sb.si.SaveMultiplier(ctx, city, zone, &SurgeMultiplier{Value: m, UpdatedAt: now}, sb.window(SurgeKindDemand)); err != nil {
+		sb.log.Warnf("save surge multiplier %s %s: %v", city, zone, err)
+	}
+	return m
+}
+
+// 按供需计算的倍数，订单数不足 min_demand 时为 1，不超过 max
+func (sb *SurgeBiz) RawMultiplier(demand, supply int64) float64 {
+	if demand < sb.cfg.GetMinDemand() || demand == 0 {
+		return 1
+	}
+	if supply < 1 {
+		supply = 1
+	}
+	ratio := float64(demand) / float64(supply)
+	if ratio <= 1 {
+		return 1
+	}
+	return math.Min(1+sb.cfg.GetSensitivity()*(ratio-1), sb.cfg.GetMax())
+}
+
+// 与上次的倍数做指数平滑，结果保留 1 位小数
+func SmoothMultiplier(previous, raw, smoothing float64) float64 {
+	if smoothing <= 0 || smoothing > 1 {
+		smoothing = 1
+	}
+	m := smoothing*raw + (1-smoothing)*previous
+	m = math.Round(m*10) / 10
+	if m < 1 {
+		m = 1
+	}
+	return m
+}
+
+// 信号的统计窗口
+func (sb *SurgeBiz) window(kind string) time.Duration {
+	if kind == SurgeKindSupply {
+		if w := sb.cfg.GetSupplyWindow().AsDuration(); w > 0 {
+			return w
+		}
+		return SurgeSupplyWindowDefault
+	}
+	if w := sb.cfg.GetDemandWindow().AsDuration(); w > 0 {
+		return w
+	}
+	return SurgeDemandWindowDefault
+}
+
+// 坐标的 geohash 编码，precision 为字符数
+func Geohash(lng, lat float64, precision int) string {
+	minLat, maxLat := -90.0, 90.0
+	minLng, maxLng := -180.0, 180.0
+	hash := make([]byte, 0, precision)
+	bit, ch, even := 0, 0, true
+	for len(hash) < precision {
+		// 偶数位编码经度，奇数位编码纬度
+		if even {
+			mid := (minLng + maxLng) / 2
+			if lng >= mid {
+				ch |= 1 << (4 - bit)
+				minLng = mid
+			} else {
+				maxLng = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if lat >= mid {
+				ch |= 1 << (4 - bit)
+				minLat = mid
+			} else {
+				maxLat = mid
+			}
+		}
+		even = !even
+		if bit < 4 {
+			bit++
+		} else {
+			hash = append(hash, geohashBase32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return string(hash)
+}
@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"valuation/internal/conf"
+)
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		lng, lat  float64
+		precision int
+		want      string
+	}{
+		{-5.6, 42.6, 5, "ezs42"},
+		{116.397128, 39.916527, 6, "wx4g0d"},
+		{121.473701, 31.230416, 6, "wtw3sj"},
+		{0, 0, 4, "s000"},
+		{116.397128, 39.916527, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := Geohash(tt.lng, tt.lat, tt.precision); got != tt.want {
+			t.Errorf("Geohash(%v, %v, %d) = %q, want %q", tt.lng, tt.lat, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestRawMultiplier(t *testing.T) {
+	sb := NewSurgeBiz(nil, &conf.Valuation{Surge: &conf.Valuation_Surge{MinDemand: 3, Sensitivity: 0.5, Max: 2}}, log.DefaultLogger)
+	tests := []struct {
+		name           string
+		demand, supply int64
+		want           float64
+	}{
+		{"订单数不足", 2, 0, 1},
+		{"供大于求", 3, 10, 1},
+		{"供需相等", 5, 5, 1},
+		{"订单是司机的 2 倍", 10, 5, 1.5},
+		{"没有司机按 1 个计算", 3, 0, 2},
+		{"不超过上限", 100, 1, 2},
+	}
+	for _, tt := range tests {
+		if got := sb.RawMultiplier(tt.demand, tt.supply); got != tt.want {
+			t.Errorf("%s: RawMultiplier(%d, %d) = %v, want %v", tt.name, tt.demand, tt.supply, got, tt.want)
+		}
+	}
+}
+
+func TestSmoothMultiplier(t *testing.T) {
+	tests := []struct {
+		previous, raw, smoothing float64
+		want                     float64
+	}{
+		{1, 2, 0.5, 1.5},
+		{2, 1, 0.3, 1.7},
+		{1, 1.84, 1, 1.8},
+		// 系数不在 (0, 1] 时不平滑
+		{1, 2, 0, 2},
+		{1, 2, 1.5, 2},
+		{0.5, 0.8, 1, 1},
+	}
+	for _, tt := range tests {
+		if got := SmoothMultiplier(tt.previous, tt.raw, tt.smoothing); got != tt.want {
+			t.Errorf("SmoothMultiplier(%v, %v, %v) = %v, want %v", tt.previous, tt.raw, tt.smoothing, got, tt.want)
+		}
+	}
+}
+
+// 只记录保存的信号
+type fakeSurge struct {
+	SurgeInterface
+	saved []string
+	err   error
+}
+
+func (f *fakeSurge) SaveSignal(ctx context.Context, city, zone, kind, member string, active bool, window time.Duration) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.saved = append(f.saved, city+":"+zone+":"+kind+":"+member)
+	return nil
+}
+
+func TestReportSignals(t *testing.T) {
+	supply := func(location, member string) *SurgeSignal {
+		return &SurgeSignal{City: "110000", Location: location, Kind: SurgeKindSupply, Member: member, Active: true}
+	}
+	tests := []struct {
+		name     string
+		signals  []*SurgeSignal
+		err      error
+		accepted int
+		saved    []string
+		wantErr  error
+	}{
+		{
+			name:     "全部记录",
+			signals:  []*SurgeSignal{supply("116.397128,39.916527", "1"), supply("121.473701,31.230416", "2")},
+			accepted: 2,
+			saved:    []string{"110000:wx4g0d:supply:1", "110000:wtw3sj:supply:2"},
+		},
+		{
+			name: "忽略参数错误的信号",
+			signals: []*SurgeSignal{
+				supply("116.397128,39.916527", "1"),
+				supply("not a location", "2"),
+				{City: "110000", Location: "116.397128,39.916527", Kind: "unknown", Member: "3"},
+				supply("116.397128,39.916527", " "),
+			},
+			accepted: 1,
+			saved:    []string{"110000:wx4g0d:supply:1"},
+		},
+		{
+			name:    "超过单次上限",
+			signals: make([]*SurgeSignal, SurgeBatchMax+1),
+			wantErr: ErrSurgeBatch,
+		},
+		{
+			name:    "存储错误",
+			signals: []*SurgeSignal{supply("116.397128,39.916527", "1")},
+			err:     errors.InternalServer("REDIS", "down"),
+			wantErr: errors.InternalServer("REDIS", "down"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si := &fakeSurge{err: tt.err}
+			sb := NewSurgeBiz(si, &conf.Valuation{}, log.DefaultLogger)
+			accepted, err := sb.ReportSignals(context.Background(), tt.signals)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || accepted != tt.accepted {
+				t.Fatalf("accepted = %d, %v, want %d", accepted, err, tt.accepted)
+			}
+			if len(si.saved) != len(tt.saved) {
+				t.Fatalf("saved %v, want %v", si.saved, tt.saved)
+			}
+			for i := range tt.saved {
+				if si.saved[i] != tt.saved[i] {
+					t.Fatalf("saved %v, want %v", si.saved, tt.saved)
+				}
+			}
+		})
+	}
+}
@@ -2,22 +2,30 @@ package biz
 
 import (
 	"context"
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
-	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"github.com/hashicorp/consul/api"
+	"database/sql"
+	"github.com/go-kratos/kratos/v2/errors"
 	"gorm.io/gorm"
-	"strconv"
+	"time"
 	"valuation/api/mapService"
 )
 
+// 金额均为最小货币单位（CNY 为分）
 type PriceRuleWork struct {
-	CityID      uint  `gorm:"" json:"city_id"`
-	StartFee    int64 `gorm:"" json:"start_fee"`        // 起步费
-	DistanceFee int64 `gorm:"" json:"distance_fee"`     // 里程费
-	DurationFee int64 `gorm:"" json:"duration_fee"`     // 时长费
-	StartAt     int   `gorm:"type:int" json:"start_at"` // 0 [0
-	EndAt       int   `gorm:"type:int" json:"end_at"`   // 7 0)
+	CityID           uint   `gorm:"" json:"city_id"`
+	StartFee         int64  `gorm:"" json:"start_fee"`                           // 起步费
+	DistanceFee      int64  `gorm:"" json:"distance_fee"`                        // 里程费，每公里
+	DurationFee      int64  `gorm:"" json:"duration_fee"`                        // 时长费，每分钟
+	IncludedDistance int64  `gorm:"not null;default:0" json:"included_distance"` // 起步费包含的里程，单位 m
+	MinFare          int64  `gorm:"not null;default:0" json:"min_fare"`          // 最低消费
+	Currency         string `gorm:"type:char(3);default:CNY" json:"currency"`    // ISO 4217 货币代码
+	StartAt          int    `gorm:"type:int" json:"start_at"`                    // 0 [0
+	EndAt            int    `gorm:"type:int" json:"end_at"`                      // 7 0)
+	// 版本的生效时间段 [effective_from, effective_to)，effective_to 为空表示长期有效
+	// 迁移前已有的规则从 2000-01-01 起生效
+	EffectiveFrom time.Time    `gorm:"not null;default:'2000-01-01 00:00:00';index" json:"effective_from"`
+	EffectiveTo   sql.NullTime `gorm:"" json:"effective_to"`
+	// 创建版本时上一个版本原来的失效时间，删除该版本时恢复
+	PrevEffectiveTo sql.NullTime `gorm:"" json:"-"`
 }
 
 type PriceRule struct {
@@ -27,94 +35,95 @@ type PriceRule struct {
 
 // 定义操作priceRule的接口
 type PriceRuleInterface interface {
-	// 获取规则
-	GetRule(cityid uint, curr int) (*PriceRule, error)
+	// 获取规则，at 时刻生效的版本中 curr 点所在时段的规则
+	GetRule(cityid uint, curr int, at time.Time) (*PriceRule, error)
+	GetRuleByID(ctx context.Context, id uint) (*PriceRule, error)
+	// 城市的规则，at 为零值时返回所有版本
+	ListRules(ctx context.Context, cityID uint, at time.Time) ([]*PriceRule, error)
+	// 城市在 [from, to) 期间生效过的所有版本的规则，按生效时间和时段排序
+	ListRulesBetween(ctx context.Context, cityID uint, from, to time.Time) ([]*PriceRule, error)
+	// 新建版本，生效时间需晚于城市最新版本的生效时间，同时让最新版本在新版本生效时失效
+	CreateRules(ctx context.Context, rules []*PriceRule) error
+	// 修改规则的费用
+	UpdateRuleFees(ctx context.Context, rule *PriceRule) error
+	// 删除城市最新的版本，并恢复上一个版本的失效时间
+	DeleteRules(ctx context.Context, cityID uint, effectiveFrom time.Time) (int64, error)
 }
 
+var ErrPriceRuleNotFound = errors.NotFound("PRICE_RULE_NOT_FOUND", "no price rule for the city and hour")
+
 type ValuationBiz struct {
 	pri PriceRuleInterface
+	ci  CityInterface
+	mc  mapService.MapServiceClient
 }
 
-func NewValuationBiz(pri PriceRuleInterface) *ValuationBiz {
+func NewValuationBiz(pri PriceRuleInterface, ci CityInterface, mc mapService.MapServiceClient) *ValuationBiz {
 	return &ValuationBiz{
 		pri: pri,
+		ci:  ci,
+		mc:  mc,
 	}
 }
 
-// 获取价格
-func (vb *ValuationBiz) GetPrice(ctx context.Context, distance, duration string, cityId uint, curr int) (int64, error) {
-	// 一，获取规则
-	rule, err := vb.pri.GetRule(cityId, curr)
-	if err != nil {
-		return 0, err
+// 获取城市在出发时刻适用的规则：出发时刻生效的版本中，城市时区的小时所在时段的规则
+// 出发时刻为过去的时间时，使用当时生效的版本，用于重新计算历史订单
+func (vb *ValuationBiz) GetRule(ctx context.Context, city *City, hour int, departure time.Time) (*PriceRule, error) {
+	rule, err := vb.pri.GetRule(city.ID, hour, departure)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPriceRuleNotFound
 	}
-	// 二，将距离和时长，转换为int64
-	distancInt64, err := strconv.ParseInt(distance, 10, 64)
 	if err != nil {
-		return 0, err
-	}
-
-	durationInt64, err := strconv.ParseInt(duration, 10, 64)
-	if err != nil {
-		return 0, err
+		return nil, err
 	}
+	return rule, nil
+}
 
-	// 三，基于rule计算
-	distancInt64 /= 1000        // 公里
-	durationInt64 /= 60         // 分钟？
-	var startDistance int64 = 5 // 起始距离 5公里？
-	total := rule.StartFee +
-		rule.DistanceFee*(distancInt64-startDistance) +
-		rule.DurationFee*durationInt64
-	return total, nil
+// 获取价格，返回分项明细
+func (vb *ValuationBiz) GetPrice(ctx context.Context, distance, duration int64, rule *PriceRule) (*Fare, error) {
+	// 基于rule计算
+	return CalcFare(rule, distance, duration), nil
 }
 
-// 获取距离和时长
-func (*ValuationBiz) GetDrivingInfo(ctx context.Context, origin, destination string) (distance string, duration string, err error) {
-	// 一，发出GRPC请求
-	// 使用服务发现
-	// 1.获取consul客户端
-	consulConfig := api.DefaultConfig()
-	consulConfig.Address = "192.168.43.144:8500"
-	consulClient, err := api.NewClient(consulConfig)
-	if err != nil {
-		return
-	}
-	// 2.获取服务发现管理器
-	dis := consul.New(consulClient)
-	// 2.1,连接目标grpc服务器
-	endpoint := "discovery:///Map"
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		//ctx,
-		grpc.WithEndpoint(endpoint), // 目标服务的名字
-		grpc.WithDiscovery(dis),     // 使用服务发现
-		// 中间件
-		grpc.WithMiddleware(
-			// tracing 的客户端中间件
-			tracing.Client(),
-		),
-	)
+// 未指定路线策略时 Map 服务使用的策略
+const RouteStrategyDefault = "fastest"
 
-	if err != nil {
-		return
-	}
-	//关闭
-	defer func() {
-		_ = conn.Close()
-	}()
+// 驾车路线的距离(m)、时长(s)、过路费(分)和 polyline
+type DrivingInfo struct {
+	Distance int64
+	Duration int64
+	Tolls    int64
+	Polyline string
+	// 地图服务商不可用，按直线距离估算
+	Estimated bool
+}
 
-	// 2.2,发送获取驾驶距离和时长请求，RPC调用
-	client := mapService.NewMapServiceClient(conn)
-	reply, err := client.GetDrivingInfo(context.Background(), &mapService.GetDrivingInfoReq{
+// 获取推荐路线的距离、时长和过路费
+// city 和 departure 用于地图服务商不可用时估算
+func (vb *ValuationBiz) GetDrivingInfo(ctx context.Context, origin, destination, strategy, city string, departure time.Time) (*DrivingInfo, error) {
+	// 发出GRPC请求，使用启动时建立的长连接
+	reply, err := vb.mc.GetDrivingInfo(ctx, &mapService.GetDrivingInfoReq{
 		Origin:      origin,
 		Destination: destination,
+		Strategy:    strategy,
+		Polyline:    true,
+		City:        city,
+		DepartureAt: departure.Unix(),
 	})
-
 	if err != nil {
-		return
+		return nil, err
+	}
+	if len(reply.Routes) == 0 {
+		return nil, errors.NotFound("ROUTE_NOT_FOUND", "no route between origin and destination")
 	}
-	distance, duration = reply.Distance, reply.Duration
+	route := reply.Routes[0]
 	// 返回正确信息
-	return
+	return &DrivingInfo{
+		Distance: route.Distance,
+		Duration: route.Duration,
+		Tolls:    route.Tolls,
+		Polyline: route.Polyline,
+		// 估算的距离和时长
+		Estimated: reply.Estimated,
+	}, nil
 }
@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"valuation/internal/biz"
+)
+
+type CityData struct {
+	data *Data
+}
+
+func NewCityInterface(data *Data) biz.CityInterface {
+	return &CityData{data: data}
+}
+
+func (cd *CityData) GetCity(ctx context.Context, id uint) (*biz.City, error) {
+	city := &biz.City{}
+	if err := cd.data.Mdb.WithContext(ctx).First(city, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrCityNotFound
+		}
+		return nil, err
+	}
+	return city, nil
+}
+
+func (cd *CityData) GetCityByCode(ctx context.Context, code string) (*biz.City, error) {
+	city := &biz.City{}
+	if err := cd.data.Mdb.WithContext(ctx).Where("code=?", code).First(city).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrCityNotFound
+		}
+		return nil, err
+	}
+	return city, nil
+}
+
+// 边界包含该坐标的城市，边界重叠时（例如直辖市内的卫星城）取面积最小的
+func (cd *CityData) GetCityByLocation(ctx context.Context, lng, lat float64) (*biz.City, error) {
+	city := &biz.City{}
+	if err := cd.data.Mdb.WithContext(ctx).
+		Where("min_lng <= ? AND max_lng >= ? AND min_lat <= ? AND max_lat >= ?", lng, lng, lat, lat).
+		Order("(max_lng - min_lng) * (max_lat - min_lat) ASC").
+		First(city).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrCityNotFound
+		}
+		return nil, err
+	}
+	return city, nil
+}
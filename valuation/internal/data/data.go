@@ -1,9 +1,12 @@
 package data
 
 import (
+	"fmt"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"time"
 	"valuation/internal/biz"
 	"valuation/internal/conf"
 
@@ -12,18 +15,31 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewPriceRuleInterface)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewPriceRuleInterface, NewCityInterface, NewFareRecordInterface, NewSurgeInterface,
+	NewClientFactory, NewMapServiceClient)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
 	// 操作MySQL的客户端
 	Mdb *gorm.DB
+	// 操作Redis的客户端，保存动态调价的供需信号
+	Rdb *redis.Client
 }
 
 // NewData .
 func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 	data := &Data{}
+	// 连接redis，使用服务的配置，此处的redis没有配置密码
+	redisURL := fmt.Sprintf("redis://%s/4?dial_timeout=%d", c.Redis.Addr, 1)
+	options, err := redis.ParseURL(redisURL)
+	if err != nil {
+		data.Rdb = nil
+		log.Fatal(err)
+	}
+	// new client 不会立即连接，需要执行命令时才会连接
+	data.Rdb = redis.NewClient(options)
+
 	// 初始Mdb
 	// 连接mysql，使用配置
 	dsn := c.Database.Source
@@ -42,45 +58,74 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 }
 
 func migrateTable(db *gorm.DB) {
-	if err := db.AutoMigrate(&biz.PriceRule{}); err != nil {
+	if err := db.AutoMigrate(&biz.PriceRule{}, &biz.City{}, &biz.FareRecord{}); err != nil {
 		log.Info("price_rule table migrate error, err:", err)
 	}
-	// 插入一些riceRule的测试数据
+	// 规则中使用的城市，已存在时不覆盖
+	cities := []biz.City{
+		{
+			Model:    gorm.Model{ID: 1},
+			Name:     "北京",
+			Code:     "110000",
+			Timezone: "Asia/Shanghai",
+			MinLng:   115.42,
+			MinLat:   39.44,
+			MaxLng:   117.51,
+			MaxLat:   41.06,
+		},
+	}
+	db.Clauses(clause.OnConflict{DoNothing: true}).Create(cities)
+	// 规则表为空时（首次部署）插入默认规则，之后通过 PriceRule 管理服务维护，启动时不再覆盖
+	var count int64
+	if err := db.Unscoped().Model(&biz.PriceRule{}).Count(&count).Error; err != nil || count > 0 {
+		return
+	}
+	effectiveFrom := time.Date(2000, 1, 1, 0, 0, 0, 0, time.Local)
 	rules := []biz.PriceRule{
 		{
-			Model: gorm.Model{ID: 1},
 			PriceRuleWork: biz.PriceRuleWork{
-				CityID:      1,
-				StartFee:    300,
-				DistanceFee: 35,
-				DurationFee: 10, // 5m
-				StartAt:     7,
-				EndAt:       23,
+				CityID:           1,
+				StartFee:         300,
+				DistanceFee:      35,
+				DurationFee:      10, // 5m
+				IncludedDistance: 5000,
+				MinFare:          300,
+				Currency:         "CNY",
+				StartAt:          7,
+				EndAt:            23,
+				EffectiveFrom:    effectiveFrom,
 			},
 		},
 		{
-			Model: gorm.Model{ID: 2},
 			PriceRuleWork: biz.PriceRuleWork{
-				CityID:      1,
-				StartFee:    350,
-				DistanceFee: 35,
-				DurationFee: 10, // 5m
-				StartAt:     23,
-				EndAt:       24,
+				CityID:           1,
+				StartFee:         350,
+				DistanceFee:      35,
+				DurationFee:      10, // 5m
+				IncludedDistance: 5000,
+				MinFare:          350,
+				Currency:         "CNY",
+				StartAt:          23,
+				EndAt:            24,
+				EffectiveFrom:    effectiveFrom,
 			},
 		},
 		{
-			Model: gorm.Model{ID: 3},
 			PriceRuleWork: biz.PriceRuleWork{
-				CityID:      1,
-				StartFee:    400,
-				DistanceFee: 35,
-				DurationFee: 10, // 5m
-				StartAt:     0,
-				EndAt:       7,
+				CityID:           1,
+				StartFee:         400,
+				DistanceFee:      35,
+				DurationFee:      10, // 5m
+				IncludedDistance: 5000,
+				MinFare:          400,
+				Currency:         "CNY",
+				StartAt:          0,
+				EndAt:            7,
+				EffectiveFrom:    effectiveFrom,
 			},
 		},
 	}
-	// 如果记录已存在，将更新所有列（字段）
-	db.Clauses(clause.OnConflict{UpdateAll: true}).Create(rules)
+	if err := db.Create(rules).Error; err != nil {
+		log.Info("price_rule seed error, err:", err)
+	}
 }
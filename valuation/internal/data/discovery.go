@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/hashicorp/consul/api"
+	grpcx "google.golang.org/grpc"
+	"valuation/api/mapService"
+	"valuation/internal/conf"
+)
+
+// 服务发现客户端工厂
+// 启动时创建一次，每个上游服务只建立一个长连接，所有请求共用，在 wire 的 cleanup 中关闭
+type ClientFactory struct {
+	dis   *consul.Registry
+	conns map[string]*grpcx.ClientConn
+}
+
+// ClientFactory 构造器
+func NewClientFactory(cs *conf.Service, logger log.Logger) (*ClientFactory, func(), error) {
+	consulConfig := api.DefaultConfig()
+	consulConfig.Address = cs.Consul.Address
+	consulClient, err := api.NewClient(consulConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	f := &ClientFactory{
+		dis:   consul.New(consulClient),
+		conns: map[string]*grpcx.ClientConn{},
+	}
+	cleanup := func() {
+		for name, conn := range f.conns {
+			if err := conn.Close(); err != nil {
+				log.NewHelper(logger).Errorf("close %s conn: %v", name, err)
+			}
+		}
+		log.NewHelper(logger).Info("closing the discovery clients")
+	}
+	return f, cleanup, nil
+}
+
+// 获取到目标服务的连接，同一服务只连接一次
+// 连接是非阻塞的，目标服务暂不可用时不影响启动，实例变化由服务发现自动更新
+func (f *ClientFactory) Conn(name string) (*grpcx.ClientConn, error) {
+	if conn, ok := f.conns[name]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("discovery:///"+name), // 目标服务的名字
+		grpc.WithDiscovery(f.dis),               // 使用服务发现
+		grpc.WithMiddleware(
+			tracing.Client(),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	f.conns[name] = conn
+	return conn, nil
+}
+
+// Map 服务的客户端
+func NewMapServiceClient(f *ClientFactory) (mapService.MapServiceClient, error) {
+	conn, err := f.Conn("Map")
+	if err != nil {
+		return nil, err
+	}
+	return mapService.NewMapServiceClient(conn), nil
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"gorm.io/gorm/clause"
+	"valuation/internal/biz"
+)
+
+type FareRecordData struct {
+	data *Data
+}
+
+func NewFareRecordInterface(data *Data) biz.FareRecordInterface {
+	return &FareRecordData{data: data}
+}
+
+// 订单已结算时（order_id 唯一索引冲突）不插入，查询已有的记录
+func (frd *FareRecordData) CreateFareRecord(ctx context.Context, record *biz.FareRecord) (*biz.FareRecord, bool, error) {
+	db := frd.data.Mdb.WithContext(ctx)
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return record, true, nil
+	}
+	saved := &biz.FareRecord{}
+	if err := db.Where("order_id=?", record.OrderID).First(saved).Error; err != nil {
+		return nil, false, err
+	}
+	return saved, false, nil
+}
@@ -0,0 +1,83 @@
+package data
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+	"valuation/internal/biz"
+)
+
+type SurgeData struct {
+	data *Data
+}
+
+func NewSurgeInterface(data *Data) biz.SurgeInterface {
+	return &SurgeData{data: data}
+}
+
+// 区域内的供需信号，zset，member 为订单id 或司机id，score 为最后更新的 unix timestamp
+func surgeSignalKey(city, zone, kind string) string {
+	return "SURGE:" + city + ":" + zone + ":" + kind
+}
+
+// 区域的调价倍数，hash，字段 value 和 updated_at
+func surgeMultiplierKey(city, zone string) string {
+	return "SURGEM:" + city + ":" + zone
+}
+
+// 记录信号，同时清理窗口外的信号
+// 区域长时间没有信号时，key 自动过期
+func (sd *SurgeData) SaveSignal(ctx context.Context, city, zone, kind, member string, active bool, window time.Duration) error {
+	key := surgeSignalKey(city, zone, kind)
+	now := time.Now()
+	_, err := sd.data.Rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if active {
+			pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.Unix()), Member: member})
+		} else {
+			pipe.ZRem(ctx, key, member)
+		}
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(now.Add(-window).Unix(), 10))
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	return err
+}
+
+func (sd *SurgeData) CountSignals(ctx context.Context, city, zone, kind string, since time.Time) (int64, error) {
+	return sd.data.Rdb.ZCount(ctx, surgeSignalKey(city, zone, kind), strconv.FormatInt(since.Unix(), 10), "+inf").Result()
+}
+
+func (sd *SurgeData) GetMultiplier(ctx context.Context, city, zone string) (*biz.SurgeMultiplier, error) {
+	values, err := sd.data.Rdb.HMGet(ctx, surgeMultiplierKey(city, zone), "value", "updated_at").Result()
+	if err != nil {
+		return nil, err
+	}
+	value, ok1 := values[0].(string)
+	updatedAt, ok2 := values[1].(string)
+	if !ok1 || !ok2 {
+		return nil, nil
+	}
+	m := &biz.SurgeMultiplier{}
+	if m.Value, err = strconv.ParseFloat(value, 64); err != nil {
+		return nil, nil
+	}
+	ts, err := strconv.ParseInt(updatedAt, 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	m.UpdatedAt = time.Unix(ts, 0)
+	return m, nil
+}
+
+func (sd *SurgeData) SaveMultiplier(ctx context.Context, city, zone string, m *biz.SurgeMultiplier, ttl time.Duration) error {
+	key := surgeMultiplierKey(city, zone)
+	_, err := sd.data.Rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"value", strconv.FormatFloat(m.Value, 'f', 1, 64),
+			"updated_at", strconv.FormatInt(m.UpdatedAt.Unix(), 10))
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
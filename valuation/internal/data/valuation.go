@@ -1,6 +1,11 @@
 package data
 
 import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 	"valuation/internal/biz"
 )
 
@@ -12,13 +17,132 @@ func NewPriceRuleInterface(data *Data) biz.PriceRuleInterface {
 	return &PriceRuleData{data: data}
 }
 
-// PriceRuleData 实现 PriceRuleInterface，curr 表示什么？
-func (prd *PriceRuleData) GetRule(cityid uint, curr int) (*biz.PriceRule, error) {
+// PriceRuleData 实现 PriceRuleInterface，curr 为出发时刻的小时，at 为出发时刻
+func (prd *PriceRuleData) GetRule(cityid uint, curr int, at time.Time) (*biz.PriceRule, error) {
 	pr := &biz.PriceRule{}
 	// "start_at <= ? AND end_at > ?" 表示当前时刻在某时间范围内，对应该范围内的规则
-	result := prd.data.Mdb.Where("city_id=? AND start_at <= ? AND end_at > ?", cityid, curr, curr).First(pr)
+	result := effectiveAt(prd.data.Mdb, at).
+		Where("city_id=? AND start_at <= ? AND end_at > ?", cityid, curr, curr).
+		First(pr)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return pr, nil
 }
+
+func (prd *PriceRuleData) GetRuleByID(ctx context.Context, id uint) (*biz.PriceRule, error) {
+	pr := &biz.PriceRule{}
+	if err := prd.data.Mdb.WithContext(ctx).First(pr, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrPriceRuleNotFound
+		}
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (prd *PriceRuleData) ListRules(ctx context.Context, cityID uint, at time.Time) ([]*biz.PriceRule, error) {
+	db := prd.data.Mdb.WithContext(ctx)
+	if !at.IsZero() {
+		db = effectiveAt(db, at)
+	}
+	rules := []*biz.PriceRule{}
+	if err := db.Where("city_id=?", cityID).
+		Order("effective_from ASC, start_at ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// [from, to) 期间生效过的版本：生效时间早于 to，且失效时间晚于 from
+func (prd *PriceRuleData) ListRulesBetween(ctx context.Context, cityID uint, from, to time.Time) ([]*biz.PriceRule, error) {
+	rules := []*biz.PriceRule{}
+	if err := prd.data.Mdb.WithContext(ctx).
+		Where("city_id=? AND effective_from < ? AND (effective_to IS NULL OR effective_to > ?)", cityID, to, from).
+		Order("effective_from ASC, start_at ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// 新建版本，在同一事务中让城市的最新版本在新版本生效时失效
+func (prd *PriceRuleData) CreateRules(ctx context.Context, rules []*biz.PriceRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	cityID, from := rules[0].CityID, rules[0].EffectiveFrom
+	return prd.data.Mdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		latest := &biz.PriceRule{}
+		// 锁住城市的最新版本，避免并发创建版本
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("city_id=?", cityID).
+			Order("effective_from DESC").
+			First(latest).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil {
+			if !from.After(latest.EffectiveFrom) {
+				return biz.ErrPriceRuleEffective
+			}
+			// 记录上一个版本原来的失效时间，删除新版本时恢复
+			for _, rule := range rules {
+				rule.PrevEffectiveTo = latest.EffectiveTo
+			}
+			if !latest.EffectiveTo.Valid || latest.EffectiveTo.Time.After(from) {
+				if err := tx.Model(&biz.PriceRule{}).
+					Where("city_id=? AND effective_from=?", cityID, latest.EffectiveFrom).
+					Update("effective_to", from).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Create(rules).Error
+	})
+}
+
+// 只更新费用相关的字段
+func (prd *PriceRuleData) UpdateRuleFees(ctx context.Context, rule *biz.PriceRule) error {
+	return prd.data.Mdb.WithContext(ctx).Model(rule).
+		Select("start_fee", "distance_fee", "duration_fee", "included_distance", "min_fare").
+		Updates(rule).Error
+}
+
+// 删除城市最新的版本，上一个版本如果是因为该版本而失效的，恢复为创建该版本时记录的原来的失效时间
+func (prd *PriceRuleData) DeleteRules(ctx context.Context, cityID uint, effectiveFrom time.Time) (int64, error) {
+	var deleted int64
+	err := prd.data.Mdb.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		latest := &biz.PriceRule{}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("city_id=?", cityID).
+			Order("effective_from DESC").
+			First(latest).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return biz.ErrPriceRuleNotFound
+			}
+			return err
+		}
+		if !latest.EffectiveFrom.Equal(effectiveFrom) {
+			if latest.EffectiveFrom.After(effectiveFrom) {
+				return biz.ErrPriceRuleVersion
+			}
+			return biz.ErrPriceRuleNotFound
+		}
+		result := tx.Where("city_id=? AND effective_from=?", cityID, effectiveFrom).Delete(&biz.PriceRule{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected
+		return tx.Model(&biz.PriceRule{}).
+			Where("city_id=? AND effective_to=?", cityID, effectiveFrom).
+			Update("effective_to", latest.PrevEffectiveTo).Error
+	})
+	return deleted, err
+}
+
+// at 时刻生效的版本
+func effectiveAt(db *gorm.DB, at time.Time) *gorm.DB {
+	return db.Where("effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)", at, at)
+}
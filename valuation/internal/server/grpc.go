@@ -1,8 +1,11 @@
 package server
 
 import (
+	"common/auth"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	v1 "valuation/api/helloworld/v1"
+	"valuation/api/priceRule"
 	"valuation/api/valuation"
 	"valuation/internal/conf"
 	"valuation/internal/service"
@@ -13,12 +16,20 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, valuationService *service.ValuationService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, ac *conf.Auth, greeter *service.GreeterService, valuationService *service.ValuationService, priceRuleService *service.PriceRuleService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			// 加入 tracing 中间件
 			tracing.Server(),
+			// 计价规则管理需要运营后台的 token
+			selector.Server(auth.Admin(ac.GetAdminSecret())).Prefix("/api.priceRule.PriceRule/").Build(),
+			// 结算和供需信号只由 Order、Driver 服务调用，需要服务间调用的 token
+			selector.Server(auth.Service(ac.GetServiceSecret())).Path(
+				valuation.Valuation_SettleFare_FullMethodName,
+				valuation.Valuation_ReportSurgeSignal_FullMethodName,
+				valuation.Valuation_ReportSurgeSignals_FullMethodName,
+			).Build(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -34,5 +45,7 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, valuationSer
 	v1.RegisterGreeterServer(srv, greeter)
 	// 注册
 	valuation.RegisterValuationServer(srv, valuationService)
+	// 计价规则管理
+	priceRule.RegisterPriceRuleServer(srv, priceRuleService)
 	return srv
 }
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"time"
+	"valuation/internal/biz"
+
+	pb "valuation/api/priceRule"
+)
+
+// 计价规则管理服务，仅提供 gRPC 接口，错误直接返回 kratos errors
+type PriceRuleService struct {
+	pb.UnimplementedPriceRuleServer
+	rb *biz.PriceRuleBiz
+}
+
+func NewPriceRuleService(rb *biz.PriceRuleBiz) *PriceRuleService {
+	return &PriceRuleService{
+		rb: rb,
+	}
+}
+
+func (s *PriceRuleService) ListPriceRules(ctx context.Context, req *pb.ListPriceRulesReq) (*pb.ListPriceRulesReply, error) {
+	rules, err := s.rb.ListRules(ctx, uint(req.CityId), unixTime(req.At))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPriceRulesReply{Rules: toPriceRuleInfos(rules)}, nil
+}
+
+func (s *PriceRuleService) GetPriceRule(ctx context.Context, req *pb.GetPriceRuleReq) (*pb.PriceRuleReply, error) {
+	rule, err := s.rb.GetRule(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PriceRuleReply{Rule: toPriceRuleInfo(rule)}, nil
+}
+
+func (s *PriceRuleService) CreatePriceRules(ctx context.Context, req *pb.CreatePriceRulesReq) (*pb.ListPriceRulesReply, error) {
+	works := make([]biz.PriceRuleWork, 0, len(req.Rules))
+	for _, r := range req.Rules {
+		works = append(works, biz.PriceRuleWork{
+			StartAt:          int(r.StartAt),
+			EndAt:            int(r.EndAt),
+			StartFee:         r.StartFee,
+			DistanceFee:      r.DistanceFee,
+			DurationFee:      r.DurationFee,
+			IncludedDistance: r.IncludedDistance,
+			MinFare:          r.MinFare,
+		})
+	}
+	rules, err := s.rb.CreateRules(ctx, uint(req.CityId), req.Currency, unixTime(req.EffectiveFrom), unixTime(req.EffectiveTo), works)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPriceRulesReply{Rules: toPriceRuleInfos(rules)}, nil
+}
+
+func (s *PriceRuleService) UpdatePriceRule(ctx context.Context, req *pb.UpdatePriceRuleReq) (*pb.PriceRuleReply, error) {
+	rule, err := s.rb.UpdateRuleFees(ctx, uint(req.Id), &biz.PriceRuleWork{
+		StartFee:         req.StartFee,
+		DistanceFee:      req.DistanceFee,
+		DurationFee:      req.DurationFee,
+		IncludedDistance: req.IncludedDistance,
+		MinFare:          req.MinFare,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PriceRuleReply{Rule: toPriceRuleInfo(rule)}, nil
+}
+
+func (s *PriceRuleService) DeletePriceRules(ctx context.Context, req *pb.DeletePriceRulesReq) (*pb.DeletePriceRulesReply, error) {
+	deleted, err := s.rb.DeleteRules(ctx, uint(req.CityId), unixTime(req.EffectiveFrom))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeletePriceRulesReply{Deleted: deleted}, nil
+}
+
+// unix timestamp 转换为时间，0 为零值
+func unixTime(ts int64) time.Time {
+	if ts <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
+
+func toPriceRuleInfo(rule *biz.PriceRule) *pb.PriceRuleInfo {
+	info := &pb.PriceRuleInfo{
+		Id:               uint64(rule.ID),
+		CityId:           uint64(rule.CityID),
+		StartAt:          int32(rule.StartAt),
+		EndAt:            int32(rule.EndAt),
+		StartFee:         rule.StartFee,
+		DistanceFee:      rule.DistanceFee,
+		DurationFee:      rule.DurationFee,
+		IncludedDistance: rule.IncludedDistance,
+		MinFare:          rule.MinFare,
+		Currency:         rule.Currency,
+		EffectiveFrom:    rule.EffectiveFrom.Unix(),
+	}
+	if rule.EffectiveTo.Valid {
+		info.EffectiveTo = rule.EffectiveTo.Time.Unix()
+	}
+	return info
+}
+
+func toPriceRuleInfos(rules []*biz.PriceRule) []*pb.PriceRuleInfo {
+	infos := make([]*pb.PriceRuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		infos = append(infos, toPriceRuleInfo(rule))
+	}
+	return infos
+}
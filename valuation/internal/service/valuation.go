@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"github.com/go-kratos/kratos/v2/errors"
+	"time"
 	"valuation/internal/biz"
 
 	pb "valuation/api/valuation"
@@ -11,29 +12,195 @@ import (
 type ValuationService struct {
 	pb.UnimplementedValuationServer
 	// 引用业务对象
-	vb *biz.ValuationBiz
+	vb    *biz.ValuationBiz
+	sb    *biz.SettlementBiz
+	surge *biz.SurgeBiz
+	qb    *biz.QuoteBiz
 }
 
-func NewValuationService(vb *biz.ValuationBiz) *ValuationService {
+func NewValuationService(vb *biz.ValuationBiz, sb *biz.SettlementBiz, surge *biz.SurgeBiz, qb *biz.QuoteBiz) *ValuationService {
 	return &ValuationService{
-		vb: vb,
+		vb:    vb,
+		sb:    sb,
+		surge: surge,
+		qb:    qb,
 	}
 }
 
 func (s *ValuationService) GetEstimatePrice(ctx context.Context, req *pb.GetEstimatePriceReq) (*pb.GetEstimatePriceReply, error) {
+	// 城市和出发时刻（城市时区）确定计价规则
+	city, err := s.vb.ResolveCity(ctx, req.City, req.Origin)
+	if err != nil {
+		return nil, err
+	}
+	hour, departure, err := city.LocalHour(req.DepartureAt)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := s.vb.GetRule(ctx, city, hour, departure)
+	if err != nil {
+		return nil, err
+	}
 	// 得到距离、时长
-	distance, duration, err := s.vb.GetDrivingInfo(ctx, req.Origin, req.Destination)
+	driving, err := s.vb.GetDrivingInfo(ctx, req.Origin, req.Destination, req.Strategy, city.Code, departure)
 	if err != nil {
 		return nil, errors.New(200, "MAP ERROR", "get driving info error")
 	}
 	// 费用
-	price, err := s.vb.GetPrice(ctx, distance, duration, 1, 9)
+	fare, err := s.vb.GetPrice(ctx, driving.Distance, driving.Duration, rule)
 	if err != nil {
 		return nil, errors.New(200, "PRICE ERROR", "cal price error")
 	}
-	return &pb.GetEstimatePriceReply{
+	// 起点所在区域的动态调价
+	zone, err := s.surge.Zone(req.Origin)
+	if err != nil {
+		return nil, err
+	}
+	fare.ApplySurge(s.surge.Multiplier(ctx, city.Code, zone))
+	fare.AddTolls(driving.Tolls)
+	// 签发报价令牌，下单时按报价计价
+	quote := &biz.Quote{
+		Origin:          req.Origin,
+		Destination:     req.Destination,
+		City:            city.Code,
+		Zone:            zone,
+		RuleID:          rule.ID,
+		RuleVersion:     rule.EffectiveFrom,
+		DepartureAt:     departure,
+		Price:           fare.Total,
+		SurgeMultiplier: fare.SurgeMultiplier,
+		Tolls:           driving.Tolls,
+		Fare:            fare,
+		Strategy:        req.Strategy,
+		Polyline:        driving.Polyline,
+		Approximate:     driving.Estimated,
+	}
+	if quote.Strategy == "" {
+		quote.Strategy = biz.RouteStrategyDefault
+	}
+	if err := s.qb.CreateQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+	return toEstimateReply(quote), nil
+}
+
+func (s *ValuationService) ReportSurgeSignal(ctx context.Context, req *pb.ReportSurgeSignalReq) (*pb.ReportSurgeSignalReply, error) {
+	zone, err := s.surge.ReportSignal(ctx, req.City, req.Location, req.Kind, req.Member, req.Active)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReportSurgeSignalReply{Zone: zone}, nil
+}
+
+func (s *ValuationService) ReportSurgeSignals(ctx context.Context, req *pb.ReportSurgeSignalsReq) (*pb.ReportSurgeSignalsReply, error) {
+	signals := make([]*biz.SurgeSignal, 0, len(req.Signals))
+	for _, sig := range req.Signals {
+		signals = append(signals, &biz.SurgeSignal{
+			City:     sig.City,
+			Location: sig.Location,
+			Kind:     sig.Kind,
+			Member:   sig.Member,
+			Active:   sig.Active,
+		})
+	}
+	accepted, err := s.surge.ReportSignals(ctx, signals)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReportSurgeSignalsReply{Accepted: int32(accepted)}, nil
+}
+
+func (s *ValuationService) SettleFare(ctx context.Context, req *pb.SettleFareReq) (*pb.SettleFareReply, error) {
+	trip := &biz.Trip{
+		OrderID:     uint(req.OrderId),
+		City:        req.City,
 		Origin:      req.Origin,
 		Destination: req.Destination,
-		Price:       price,
+		Track:       make([]biz.TrackPoint, 0, len(req.Track)),
+		Odometer:    req.Odometer,
+		StartedAt:   unixTime(req.StartedAt),
+		EndedAt:     unixTime(req.EndedAt),
+		ArrivedAt:   unixTime(req.ArrivedAt),
+		QuotedPrice: req.QuotedPrice,
+		Surge:       req.SurgeMultiplier,
+		Tolls:       req.Tolls,
+	}
+	for _, p := range req.Track {
+		trip.Track = append(trip.Track, biz.TrackPoint{Lng: p.Lng, Lat: p.Lat, At: time.Unix(p.At, 0)})
+	}
+	record, created, err := s.sb.SettleFare(ctx, trip)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*pb.FareSegment, 0, len(record.Fare.Segments))
+	for _, seg := range record.Fare.Segments {
+		segments = append(segments, &pb.FareSegment{
+			RuleId:   uint64(seg.RuleID),
+			StartAt:  seg.StartAt.Unix(),
+			EndAt:    seg.EndAt.Unix(),
+			Distance: seg.Distance,
+			Duration: seg.Duration,
+		})
+	}
+	return &pb.SettleFareReply{
+		FareId:      uint64(record.ID),
+		OrderId:     uint64(record.OrderID),
+		City:        record.City,
+		Price:       record.Total,
+		QuotedPrice: record.QuotedPrice,
+		Waiting:     record.Waiting,
+		Fare:        toFareBreakdown(record.Fare),
+		Segments:    segments,
+		Settled:     !created,
+		SettledAt:   record.CreatedAt.Unix(),
 	}, nil
 }
+
+func toEstimateReply(quote *biz.Quote) *pb.GetEstimatePriceReply {
+	return &pb.GetEstimatePriceReply{
+		Origin:          quote.Origin,
+		Destination:     quote.Destination,
+		Price:           quote.Price,
+		RuleId:          uint64(quote.RuleID),
+		City:            quote.City,
+		DepartureAt:     quote.DepartureAt.Unix(),
+		Fare:            toFareBreakdown(quote.Fare),
+		SurgeMultiplier: quote.SurgeMultiplier,
+		Zone:            quote.Zone,
+		QuoteId:         quote.ID,
+		ExpiresAt:       quote.ExpiresAt.Unix(),
+		QuoteToken:      quote.Token,
+		Strategy:        quote.Strategy,
+		Polyline:        quote.Polyline,
+		Approximate:     quote.Approximate,
+	}
+}
+
+func toFareBreakdown(fare *biz.Fare) *pb.FareBreakdown {
+	return &pb.FareBreakdown{
+		Currency:         fare.Currency,
+		MinorUnit:        fare.MinorUnit,
+		Distance:         fare.Distance,
+		Duration:         fare.Duration,
+		StartFee:         fare.StartFee,
+		IncludedDistance: fare.IncludedDistance,
+		BillableDistance: fare.BillableDistance,
+		DistanceFee:      fare.DistanceFee,
+		DurationFee:      fare.DurationFee,
+		Surcharges:       toFareItems(fare.Surcharges),
+		Discounts:        toFareItems(fare.Discounts),
+		Total:            fare.Total,
+	}
+}
+
+func toFareItems(items []*biz.FareItem) []*pb.FareItem {
+	result := make([]*pb.FareItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &pb.FareItem{
+			Code:   item.Code,
+			Name:   item.Name,
+			Amount: item.Amount,
+		})
+	}
+	return result
+}
@@ -20,6 +20,9 @@ const _ = grpc.SupportPackageIsVersion8
 
 const (
 	VerifyCode_GetVerifyCode_FullMethodName = "/api.verifyCode.VerifyCode/GetVerifyCode"
+	VerifyCode_Issue_FullMethodName         = "/api.verifyCode.VerifyCode/Issue"
+	VerifyCode_Verify_FullMethodName        = "/api.verifyCode.VerifyCode/Verify"
+	VerifyCode_Allow_FullMethodName         = "/api.verifyCode.VerifyCode/Allow"
 )
 
 // VerifyCodeClient is the client API for VerifyCode service.
@@ -27,6 +30,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VerifyCodeClient interface {
 	GetVerifyCode(ctx context.Context, in *GetVerifyCodeRequest, opts ...grpc.CallOption) (*GetVerifyCodeReply, error)
+	Issue(ctx context.Context, in *IssueRequest, opts ...grpc.CallOption) (*IssueReply, error)
+	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyReply, error)
+	Allow(ctx context.Context, in *AllowRequest, opts ...grpc.CallOption) (*AllowReply, error)
 }
 
 type verifyCodeClient struct {
@@ -47,11 +53,44 @@ func (c *verifyCodeClient) GetVerifyCode(ctx context.Context, in *GetVerifyCodeR
 	return out, nil
 }
 
+func (c *verifyCodeClient) Issue(ctx context.Context, in *IssueRequest, opts ...grpc.CallOption) (*IssueReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueReply)
+	err := c.cc.Invoke(ctx, VerifyCode_Issue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifyCodeClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyReply)
+	err := c.cc.Invoke(ctx, VerifyCode_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *verifyCodeClient) Allow(ctx context.Context, in *AllowRequest, opts ...grpc.CallOption) (*AllowReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllowReply)
+	err := c.cc.Invoke(ctx, VerifyCode_Allow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VerifyCodeServer is the server API for VerifyCode service.
 // All implementations must embed UnimplementedVerifyCodeServer
 // for forward compatibility
 type VerifyCodeServer interface {
 	GetVerifyCode(context.Context, *GetVerifyCodeRequest) (*GetVerifyCodeReply, error)
+	Issue(context.Context, *IssueRequest) (*IssueReply, error)
+	Verify(context.Context, *VerifyRequest) (*VerifyReply, error)
+	Allow(context.Context, *AllowRequest) (*AllowReply, error)
 	mustEmbedUnimplementedVerifyCodeServer()
 }
 
@@ -62,6 +101,15 @@ type UnimplementedVerifyCodeServer struct {
 func (UnimplementedVerifyCodeServer) GetVerifyCode(context.Context, *GetVerifyCodeRequest) (*GetVerifyCodeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVerifyCode not implemented")
 }
+func (UnimplementedVerifyCodeServer) Issue(context.Context, *IssueRequest) (*IssueReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issue not implemented")
+}
+func (UnimplementedVerifyCodeServer) Verify(context.Context, *VerifyRequest) (*VerifyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedVerifyCodeServer) Allow(context.Context, *AllowRequest) (*AllowReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allow not implemented")
+}
 func (UnimplementedVerifyCodeServer) mustEmbedUnimplementedVerifyCodeServer() {}
 
 // UnsafeVerifyCodeServer may be embedded to opt out of forward compatibility for this service.
@@ -93,6 +141,60 @@ func _VerifyCode_GetVerifyCode_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VerifyCode_Issue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyCodeServer).Issue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyCode_Issue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyCodeServer).Issue(ctx, req.(*IssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VerifyCode_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyCodeServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyCode_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyCodeServer).Verify(ctx, req.(*VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VerifyCode_Allow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyCodeServer).Allow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyCode_Allow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyCodeServer).Allow(ctx, req.(*AllowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VerifyCode_ServiceDesc is the grpc.ServiceDesc for VerifyCode service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -104,6 +206,18 @@ var VerifyCode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVerifyCode",
 			Handler:    _VerifyCode_GetVerifyCode_Handler,
 		},
+		{
+			MethodName: "Issue",
+			Handler:    _VerifyCode_Issue_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _VerifyCode_Verify_Handler,
+		},
+		{
+			MethodName: "Allow",
+			Handler:    _VerifyCode_Allow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/verifyCode/verifyCode.proto",
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confService *conf.Service, confServer *conf.Server, confData *conf.Data, otp *conf.Otp, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -31,10 +31,14 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	verifyCodeService := service.NewVerifyCodeService()
+	otpRepo := data.NewOtpRepo(dataData)
+	otpBiz := biz.NewOtpBiz(otpRepo, otp, logger)
+	limitRepo := data.NewLimitRepo(dataData)
+	limitBiz := biz.NewLimitBiz(limitRepo, otp, logger)
+	verifyCodeService := service.NewVerifyCodeService(otpBiz, limitBiz)
 	grpcServer := server.NewGRPCServer(confServer, greeterService, verifyCodeService, logger)
 	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	app := newApp(confService, logger, grpcServer, httpServer)
 	return app, func() {
 		cleanup()
 	}, nil
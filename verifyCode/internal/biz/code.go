@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/bits"
+	"strings"
+
+	pb "verifyCode/api/verifyCode"
+)
+
+// 验证码长度
+const VerifyCodeLengthDefault = 6
+const VerifyCodeLengthMin = 4
+const VerifyCodeLengthMax = 32
+
+// 自定义字符集的长度
+const AlphabetLengthMin = 2
+const AlphabetLengthMax = 64
+
+// 各类型的字符集
+const (
+	AlphabetDigit  = "0123456789"
+	AlphabetLetter = "abcdefghijklmnopqrstuvwxyz"
+	AlphabetMixed  = "0123456789abcdefghijklmnopqrstuvwxyz"
+	AlphabetSafe   = "23456789abcdefghjkmnpqrstuvwxyz"
+)
+
+var (
+	ErrCodeLength = errors.BadRequest("VERIFY_CODE_PARAM_ERROR", "length must be between 4 and 32")
+	ErrCodeType   = errors.BadRequest("VERIFY_CODE_PARAM_ERROR", "unknown code type")
+	ErrAlphabet   = errors.BadRequest("VERIFY_CODE_PARAM_ERROR", "alphabet must be 2-64 unique printable ASCII characters")
+)
+
+// 生成验证码，length 为 0 时使用默认长度
+func NewCode(length int, t pb.TYPE, alphabet string) (string, error) {
+	if length == 0 {
+		length = VerifyCodeLengthDefault
+	}
+	if length < VerifyCodeLengthMin || length > VerifyCodeLengthMax {
+		return "", ErrCodeLength
+	}
+	chars, err := Alphabet(t, alphabet)
+	if err != nil {
+		return "", err
+	}
+	code, err := RandCode(chars, length)
+	if err != nil {
+		return "", errors.InternalServer("VERIFY_CODE_ERROR", "generate code error").WithCause(err)
+	}
+	return code, nil
+}
+
+// 验证码使用的字符集，自定义字符集优先
+func Alphabet(t pb.TYPE, custom string) (string, error) {
+	if custom != "" {
+		if len(custom) < AlphabetLengthMin || len(custom) > AlphabetLengthMax {
+			return "", ErrAlphabet
+		}
+		seen := [128]bool{}
+		for i := 0; i < len(custom); i++ {
+			c := custom[i]
+			// 可打印 ASCII，不含空格
+			if c <= ' ' || c > '~' || seen[c] {
+				return "", ErrAlphabet
+			}
+			seen[c] = true
+		}
+		return custom, nil
+	}
+	switch t {
+	case pb.TYPE_DEFAULT, pb.TYPE_DIGIT:
+		return AlphabetDigit, nil
+	case pb.TYPE_LETTER:
+		return AlphabetLetter, nil
+	case pb.TYPE_MIXED:
+		return AlphabetMixed, nil
+	case pb.TYPE_SAFE:
+		return AlphabetSafe, nil
+	}
+	return "", ErrCodeType
+}
+
+// 随机数的核心方法，使用 crypto/rand，验证码不可预测
+// 一次读取 64 个随机位，分部分多次使用；每次取低 idxBits 位作为索引，超出字符集的丢弃，保证每个字符的概率相同
+func RandCode(chars string, l int) (string, error) {
+	// 表示完 chars 的索引需要的位数，例如 10 个字符需要 4 位
+	idxBits := bits.Len(uint(len(chars) - 1))
+	if idxBits == 0 {
+		idxBits = 1
+	}
+	// 形成掩码，例如，使用低idxBits位：00000000000001111
+	idxMask := uint64(1)<<idxBits - 1
+	// 64 位可以用多少次
+	idxMax := 64 / idxBits
+	// 利用string builder构建结果缓冲
+	sb := strings.Builder{}
+	sb.Grow(l) //提前分配足够的内存
+	buf := make([]byte, 8)
+	var cache uint64
+	// remain:当前还可以用几次
+	for i, remain := 0, 0; i < l; {
+		// 如果使用的剩余次数为0，则重新读取随机位
+		if remain == 0 {
+			if _, err := rand.Read(buf); err != nil {
+				return "", err
+			}
+			cache, remain = binary.LittleEndian.Uint64(buf), idxMax
+		}
+		// 利用掩码获取cache的低位作为randIndex（索引）
+		if randIndex := int(cache & idxMask); randIndex < len(chars) {
+			sb.WriteByte(chars[randIndex])
+			i++
+		}
+		// 使用下一组随机位。右移会丢掉先前的低位
+		cache >>= idxBits
+		remain--
+	}
+	return sb.String(), nil
+}
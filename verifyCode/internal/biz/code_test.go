@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	pb "verifyCode/api/verifyCode"
+)
+
+func TestNewCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		length   int
+		typ      pb.TYPE
+		alphabet string
+		want     string // 验证码只能包含的字符
+		wantLen  int
+		wantErr  error
+	}{
+		{"默认长度", 0, pb.TYPE_DEFAULT, "", AlphabetDigit, VerifyCodeLengthDefault, nil},
+		{"数字", 4, pb.TYPE_DIGIT, "", AlphabetDigit, 4, nil},
+		{"字母", 8, pb.TYPE_LETTER, "", AlphabetLetter, 8, nil},
+		{"数字和字母", 32, pb.TYPE_MIXED, "", AlphabetMixed, 32, nil},
+		{"去掉易混淆的字符", 16, pb.TYPE_SAFE, "", AlphabetSafe, 16, nil},
+		{"自定义字符集优先", 6, pb.TYPE_LETTER, "AB", "AB", 6, nil},
+		{"太短", 3, pb.TYPE_DIGIT, "", "", 0, ErrCodeLength},
+		{"太长", 33, pb.TYPE_DIGIT, "", "", 0, ErrCodeLength},
+		{"未知类型", 6, pb.TYPE(99), "", "", 0, ErrCodeType},
+		{"字符集只有一个字符", 6, pb.TYPE_DIGIT, "A", "", 0, ErrAlphabet},
+		{"字符集有重复", 6, pb.TYPE_DIGIT, "ABA", "", 0, ErrAlphabet},
+		{"字符集包含空格", 6, pb.TYPE_DIGIT, "A B", "", 0, ErrAlphabet},
+		{"字符集包含非 ASCII", 6, pb.TYPE_DIGIT, "AB验", "", 0, ErrAlphabet},
+		{"字符集太长", 6, pb.TYPE_DIGIT, AlphabetMixed + "ABCDEFGHIJKLMNOPQRSTUVWXYZ!#$", "", 0, ErrAlphabet},
+	}
+	for _, tt := range tests {
+		code, err := NewCode(tt.length, tt.typ, tt.alphabet)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || len(code) != tt.wantLen {
+			t.Errorf("%s: NewCode = %q, %v, want length %d", tt.name, code, err, tt.wantLen)
+			continue
+		}
+		if i := strings.IndexFunc(code, func(r rune) bool { return !strings.ContainsRune(tt.want, r) }); i >= 0 {
+			t.Errorf("%s: %q contains %q not in %q", tt.name, code, code[i], tt.want)
+		}
+	}
+}
+
+func TestRandCodeDistribution(t *testing.T) {
+	// 字符集长度不是 2 的幂时，超出字符集的索引被丢弃，每个字符的概率仍然相同
+	tests := []string{AlphabetDigit, AlphabetSafe, "AB", "ABC"}
+	const n = 200000
+	for _, chars := range tests {
+		code, err := RandCode(chars, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != n {
+			t.Fatalf("%q: length %d, want %d", chars, len(code), n)
+		}
+		counts := map[byte]int{}
+		for i := 0; i < len(code); i++ {
+			counts[code[i]]++
+		}
+		expected := float64(n) / float64(len(chars))
+		for i := 0; i < len(chars); i++ {
+			// 偏差超过 5% 的概率可以忽略
+			if got := float64(counts[chars[i]]); got < expected*0.95 || got > expected*1.05 {
+				t.Errorf("%q: %q appears %.0f times, want about %.0f", chars, chars[i], got, expected)
+			}
+		}
+		if len(counts) != len(chars) {
+			t.Errorf("%q: %d distinct characters, want %d", chars, len(counts), len(chars))
+		}
+	}
+}
+
+func TestRandCodeUnique(t *testing.T) {
+	// 6 位数字的验证码，1000 次中重复的概率约为 0.4，不要求唯一；8 位字母数字的验证码不应重复
+	seen := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		code, err := RandCode(AlphabetMixed, 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[code] {
+			t.Fatalf("duplicate code %q", code)
+		}
+		seen[code] = true
+	}
+}
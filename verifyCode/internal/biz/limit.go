@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"strconv"
+	"time"
+	"verifyCode/internal/conf"
+)
+
+// IP 和设备 ID 的最大长度，超出时使用哈希值计数
+const LimitClientMax = 64
+
+// 限流的存储不可用时拒绝签发，建议客户端等待的时长
+const LimitErrorRetry = 5 * time.Second
+
+// 被拒绝的原因
+const (
+	LimitPassed = iota
+	LimitBreaker
+	LimitCooldown
+	LimitSubjectDaily
+	LimitIPDaily
+	LimitDeviceDaily
+)
+
+var (
+	ErrOtpTooFrequent = errors.New(429, "VERIFY_CODE_TOO_FREQUENT", "verify code requested too frequently")
+	ErrOtpDailyLimit  = errors.New(429, "VERIFY_CODE_DAILY_LIMIT", "verify code daily limit reached")
+	ErrOtpBusy        = errors.ServiceUnavailable("VERIFY_CODE_BUSY", "verify code service is busy, try again later")
+)
+
+// 一次签发需要检查和计数的 key，为空的 key 不检查
+type LimitKeys struct {
+	Breaker  string
+	Cooldown string
+	Subject  string
+	IP       string
+	Device   string
+	Global   string
+}
+
+// 签发频率限制相关的资源操作接口
+type LimitRepo interface {
+	// 依次检查熔断、冷却和每天的次数，全部通过时计入次数并开始冷却
+	// 返回被拒绝的原因和需要等待的时长，通过时为 LimitPassed
+	Allow(ctx context.Context, keys *LimitKeys, limit *conf.Otp_Limit, dayLeft time.Duration) (int, time.Duration, error)
+}
+
+// 验证码签发的频率限制：同一号码的冷却、号码/IP/设备每天的次数、全局熔断
+// 计数在验证码服务中完成，顾客服务和司机服务的中间件调用 Allow
+type LimitBiz struct {
+	repo   LimitRepo
+	limit  *conf.Otp_Limit
+	scenes map[string]*conf.Otp_Scene
+	log    *log.Helper
+}
+
+func NewLimitBiz(repo LimitRepo, oc *conf.Otp, logger log.Logger) *LimitBiz {
+	limit := oc.GetLimit()
+	if limit == nil {
+		limit = &conf.Otp_Limit{}
+	}
+	return &LimitBiz{
+		repo:   repo,
+		limit:  limit,
+		scenes: oc.GetScenes(),
+		log:    log.NewHelper(logger),
+	}
+}
+
+// 检查是否允许签发，ip 和 device 为空时不限制
+func (lb *LimitBiz) Allow(ctx context.Context, scene, subject, ip, device string) error {
+	if _, ok := lb.scenes[scene]; !ok {
+		return ErrOtpScene
+	}
+	if !otpSubject(subject) {
+		return ErrOtpSubject
+	}
+	now := time.Now()
+	day := now.Format("20060102")
+	keys := &LimitKeys{}
+	if lb.limit.GetGlobalMax() > 0 && lb.limit.GetGlobalWindow().AsDuration() > 0 && lb.limit.GetBreaker().AsDuration() > 0 {
+		window := lb.limit.GetGlobalWindow().AsDuration()
+		keys.Breaker = "breaker"
+		keys.Global = "global:" + strconv.FormatInt(now.UnixNano()/int64(window), 10)
+	}
+	if lb.limit.GetCooldown().AsDuration() > 0 {
+		keys.Cooldown = "cooldown:" + scene + ":" + subject
+	}
+	if lb.limit.GetSubjectDaily() > 0 {
+		keys.Subject = "subject:" + day + ":" + scene + ":" + subject
+	}
+	if lb.limit.GetIpDaily() > 0 && ip != "" {
+		keys.IP = "ip:" + day + ":" + limitClient(ip)
+	}
+	if lb.limit.GetDeviceDaily() > 0 && device != "" {
+		keys.Device = "device:" + day + ":" + limitClient(device)
+	}
+	// 每天的次数到当天结束时清零
+	y, m, d := now.Date()
+	dayLeft := time.Date(y, m, d+1, 0, 0, 0, 0, now.Location()).Sub(now)
+	reason, wait, err := lb.repo.Allow(ctx, keys, lb.limit, dayLeft)
+	var e *errors.Error
+	if err != nil {
+		// 限流的存储不可用时拒绝签发，验证码也保存在同一个 Redis 中，签发了也无法校验
+		lb.log.WithContext(ctx).Errorf("verify code limit: %v", err)
+		reason, wait = LimitBreaker, LimitErrorRetry
+	}
+	switch reason {
+	case LimitPassed:
+		return nil
+	case LimitBreaker:
+		if err == nil {
+			lb.log.WithContext(ctx).Warnf("verify code breaker open, retry after %s", wait)
+		}
+		e = ErrOtpBusy
+	case LimitCooldown:
+		e = ErrOtpTooFrequent
+	case LimitSubjectDaily:
+		e = ErrOtpDailyLimit.WithMetadata(map[string]string{"limit": "subject"})
+	case LimitIPDaily:
+		e = ErrOtpDailyLimit.WithMetadata(map[string]string{"limit": "ip"})
+	case LimitDeviceDaily:
+		e = ErrOtpDailyLimit.WithMetadata(map[string]string{"limit": "device"})
+	default:
+		return nil
+	}
+	md := map[string]string{}
+	for k, v := range e.Metadata {
+		md[k] = v
+	}
+	md["retry_after"] = strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10)
+	return e.WithMetadata(md)
+}
+
+// 超过 LimitClientMax 的值使用哈希值，避免过长的 key，同时仍然计数
+func limitClient(v string) string {
+	if len(v) <= LimitClientMax {
+		return v
+	}
+	sum := sha256.Sum256([]byte(v))
+	return "h:" + hex.EncodeToString(sum[:])[:32]
+}
@@ -0,0 +1,211 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"verifyCode/internal/conf"
+)
+
+// 内存中的 LimitRepo，与 Lua 脚本的检查顺序一致：冷却、号码、IP、设备，全部通过时才计数
+type fakeLimitRepo struct {
+	cooldown map[string]bool
+	counts   map[string]int32
+	keys     *LimitKeys
+	err      error
+}
+
+func newFakeLimitRepo() *fakeLimitRepo {
+	return &fakeLimitRepo{cooldown: map[string]bool{}, counts: map[string]int32{}}
+}
+
+func (f *fakeLimitRepo) Allow(ctx context.Context, keys *LimitKeys, limit *conf.Otp_Limit, dayLeft time.Duration) (int, time.Duration, error) {
+	f.keys = keys
+	if f.err != nil {
+		return 0, 0, f.err
+	}
+	if keys.Cooldown != "" && f.cooldown[keys.Cooldown] {
+		return LimitCooldown, limit.GetCooldown().AsDuration(), nil
+	}
+	daily := []struct {
+		key    string
+		max    int32
+		reason int
+	}{
+		{keys.Subject, limit.GetSubjectDaily(), LimitSubjectDaily},
+		{keys.IP, limit.GetIpDaily(), LimitIPDaily},
+		{keys.Device, limit.GetDeviceDaily(), LimitDeviceDaily},
+	}
+	for _, d := range daily {
+		if d.key != "" && f.counts[d.key] >= d.max {
+			return d.reason, dayLeft, nil
+		}
+	}
+	for _, d := range daily {
+		if d.key != "" {
+			f.counts[d.key]++
+		}
+	}
+	if keys.Cooldown != "" {
+		f.cooldown[keys.Cooldown] = true
+	}
+	return LimitPassed, 0, nil
+}
+
+func newTestLimitBiz(repo LimitRepo, limit *conf.Otp_Limit) *LimitBiz {
+	return NewLimitBiz(repo, &conf.Otp{
+		Scenes: map[string]*conf.Otp_Scene{"customer_login": {}},
+		Limit:  limit,
+	}, log.DefaultLogger)
+}
+
+// 每次请求的号码、IP、设备
+type limitCall struct {
+	subject, ip, device string
+}
+
+func TestLimitAllow(t *testing.T) {
+	const scene = "customer_login"
+	daily := &conf.Otp_Limit{SubjectDaily: 2, IpDaily: 3, DeviceDaily: 2}
+	longIP := strings.Repeat("1", LimitClientMax+1)
+	tests := []struct {
+		name    string
+		limit   *conf.Otp_Limit
+		calls   []limitCall
+		wantErr []*errors.Error
+		// 被拒绝时 metadata 中的 limit
+		wantLimit string
+	}{
+		{
+			"冷却期内拒绝",
+			&conf.Otp_Limit{Cooldown: durationpb.New(time.Minute)},
+			[]limitCall{{"13800000000", "", ""}, {"13800000000", "", ""}, {"13800000001", "", ""}},
+			[]*errors.Error{nil, ErrOtpTooFrequent, nil},
+			"",
+		},
+		{
+			"号码每天的次数",
+			daily,
+			[]limitCall{{"13800000000", "", ""}, {"13800000000", "", ""}, {"13800000000", "", ""}},
+			[]*errors.Error{nil, nil, ErrOtpDailyLimit},
+			"subject",
+		},
+		{
+			"IP 每天的次数",
+			daily,
+			[]limitCall{{"13800000000", "1.1.1.1", ""}, {"13800000001", "1.1.1.1", ""}, {"13800000002", "1.1.1.1", ""}, {"13800000003", "1.1.1.1", ""}, {"13800000003", "2.2.2.2", ""}},
+			[]*errors.Error{nil, nil, nil, ErrOtpDailyLimit, nil},
+			"ip",
+		},
+		{
+			"设备每天的次数",
+			daily,
+			[]limitCall{{"13800000000", "", "d1"}, {"13800000001", "", "d1"}, {"13800000002", "", "d1"}},
+			[]*errors.Error{nil, nil, ErrOtpDailyLimit},
+			"device",
+		},
+		{
+			"过长的 IP 仍然计数",
+			daily,
+			[]limitCall{{"13800000000", longIP, ""}, {"13800000001", longIP, ""}, {"13800000002", longIP, ""}, {"13800000003", longIP, ""}},
+			[]*errors.Error{nil, nil, nil, ErrOtpDailyLimit},
+			"ip",
+		},
+		{
+			"未配置时不限制",
+			&conf.Otp_Limit{},
+			[]limitCall{{"13800000000", "1.1.1.1", "d1"}, {"13800000000", "1.1.1.1", "d1"}, {"13800000000", "1.1.1.1", "d1"}},
+			[]*errors.Error{nil, nil, nil},
+			"",
+		},
+		{
+			"未知场景",
+			daily,
+			nil,
+			nil,
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lb := newTestLimitBiz(newFakeLimitRepo(), tt.limit)
+			ctx := context.Background()
+			if tt.calls == nil {
+				if err := lb.Allow(ctx, "unknown", "13800000000", "", ""); !errors.Is(err, ErrOtpScene) {
+					t.Fatalf("Allow = %v, want %v", err, ErrOtpScene)
+				}
+				return
+			}
+			for i, c := range tt.calls {
+				err := lb.Allow(ctx, scene, c.subject, c.ip, c.device)
+				want := tt.wantErr[i]
+				if want == nil {
+					if err != nil {
+						t.Fatalf("call %d: Allow = %v, want nil", i, err)
+					}
+					continue
+				}
+				e := errors.FromError(err)
+				if e.Reason != want.Reason || e.Code != want.Code {
+					t.Fatalf("call %d: Allow = %v, want %v", i, err, want)
+				}
+				if e.Metadata["retry_after"] == "" {
+					t.Errorf("call %d: retry_after missing", i)
+				}
+				if tt.wantLimit != "" && e.Metadata["limit"] != tt.wantLimit {
+					t.Errorf("call %d: limit = %q, want %q", i, e.Metadata["limit"], tt.wantLimit)
+				}
+			}
+		})
+	}
+}
+
+func TestLimitAllowRepoError(t *testing.T) {
+	repo := newFakeLimitRepo()
+	repo.err = fmt.Errorf("redis: connection refused")
+	lb := newTestLimitBiz(repo, &conf.Otp_Limit{Cooldown: durationpb.New(time.Minute)})
+	err := lb.Allow(context.Background(), "customer_login", "13800000000", "", "")
+	e := errors.FromError(err)
+	if e.Reason != ErrOtpBusy.Reason || e.Code != ErrOtpBusy.Code {
+		t.Fatalf("Allow = %v, want %v", err, ErrOtpBusy)
+	}
+	if e.Metadata["retry_after"] != "5" {
+		t.Errorf("retry_after = %q, want 5", e.Metadata["retry_after"])
+	}
+}
+
+func TestLimitClient(t *testing.T) {
+	long := strings.Repeat("a", LimitClientMax+1)
+	tests := []struct {
+		name string
+		v    string
+		hash bool
+	}{
+		{"IPv4", "1.1.1.1", false},
+		{"最大长度", strings.Repeat("a", LimitClientMax), false},
+		{"超出最大长度", long, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := limitClient(tt.v)
+			if !tt.hash {
+				if got != tt.v {
+					t.Fatalf("limitClient = %q, want %q", got, tt.v)
+				}
+				return
+			}
+			if !strings.HasPrefix(got, "h:") || len(got) != 34 {
+				t.Fatalf("limitClient = %q, want h: and 32 hex", got)
+			}
+			if got != limitClient(tt.v) || got == limitClient(long+"b") {
+				t.Fatalf("limitClient is not a stable hash: %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"verifyCode/internal/conf"
+
+	pb "verifyCode/api/verifyCode"
+)
+
+// 场景配置缺失时的默认值
+const OtpTTLDefault = 60 * time.Second
+const OtpMaxAttemptsDefault = 5
+const OtpLockoutDefault = 15 * time.Minute
+
+// 接收方的最大长度
+const OtpSubjectMax = 64
+
+var (
+	ErrOtpScene    = errors.BadRequest("VERIFY_CODE_PARAM_ERROR", "unknown scene")
+	ErrOtpSubject  = errors.BadRequest("VERIFY_CODE_PARAM_ERROR", "subject must be 1-64 characters without spaces")
+	ErrOtpMismatch = errors.BadRequest("VERIFY_CODE_MISMATCH", "verify code mismatch")
+	ErrOtpExpired  = errors.NotFound("VERIFY_CODE_EXPIRED", "verify code expired or used")
+	ErrOtpLocked   = errors.Forbidden("VERIFY_CODE_LOCKED", "too many failed attempts, try again later")
+)
+
+// 一次性验证码相关的资源操作接口，key 由场景和接收方组成
+type OtpRepo interface {
+	// 剩余的锁定时长，未锁定时为 0
+	Locked(ctx context.Context, key string) (time.Duration, error)
+	// 保存验证码，覆盖之前的验证码
+	SaveCode(ctx context.Context, key, code string, ttl time.Duration) error
+	// 未签发或已过期时返回空字符串
+	GetCode(ctx context.Context, key string) (string, error)
+	// 删除验证码，并发校验时只有一个请求返回 true
+	ConsumeCode(ctx context.Context, key string) (bool, error)
+	// 记录一次校验失败，返回窗口内的失败次数；达到 max 时删除验证码并锁定
+	Fail(ctx context.Context, key string, max int64, lockout time.Duration) (int64, error)
+	// 校验成功后清除失败次数
+	ResetAttempts(ctx context.Context, key string) error
+}
+
+// 场景的配置
+type OtpScene struct {
+	TTL         time.Duration
+	Length      int
+	Type        pb.TYPE
+	MaxAttempts int64
+	Lockout     time.Duration
+}
+
+// 一次性验证码：签发、存储、校验、作废
+// 客户和司机登录共用，验证码只能校验成功一次，比较使用常量时间，失败次数过多时锁定
+type OtpBiz struct {
+	repo   OtpRepo
+	scenes map[string]*OtpScene
+	log    *log.Helper
+}
+
+func NewOtpBiz(repo OtpRepo, oc *conf.Otp, logger log.Logger) *OtpBiz {
+	ob := &OtpBiz{
+		repo:   repo,
+		scenes: map[string]*OtpScene{},
+		log:    log.NewHelper(logger),
+	}
+	for name, sc := range oc.GetScenes() {
+		scene := &OtpScene{
+			TTL:         sc.GetTtl().AsDuration(),
+			Length:      int(sc.GetLength()),
+			Type:        pb.TYPE_DIGIT,
+			MaxAttempts: int64(sc.GetMaxAttempts()),
+			Lockout:     sc.GetLockout().AsDuration(),
+		}
+		if scene.TTL <= 0 {
+			scene.TTL = OtpTTLDefault
+		}
+		if t, ok := pb.TYPE_value[strings.ToUpper(sc.GetType())]; ok && sc.GetType() != "" {
+			scene.Type = pb.TYPE(t)
+		}
+		if scene.MaxAttempts <= 0 {
+			scene.MaxAttempts = OtpMaxAttemptsDefault
+		}
+		if scene.Lockout <= 0 {
+			scene.Lockout = OtpLockoutDefault
+		}
+		ob.scenes[name] = scene
+	}
+	return ob
+}
+
+// 签发验证码，锁定期间不能签发
+func (ob *OtpBiz) Issue(ctx context.Context, scene, subject string) (string, time.Duration, error) {
+	sc, key, err := ob.scene(scene, subject)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := ob.checkLocked(ctx, key); err != nil {
+		return "", 0, err
+	}
+	code, err := NewCode(sc.Length, sc.Type, "")
+	if err != nil {
+		return "", 0, err
+	}
+	if err := ob.repo.SaveCode(ctx, key, code, sc.TTL); err != nil {
+		return "", 0, otpStoreError(err)
+	}
+	return code, sc.TTL, nil
+}
+
+// 校验验证码，成功后验证码失效
+func (ob *OtpBiz) Verify(ctx context.Context, scene, subject, code string) error {
+	sc, key, err := ob.scene(scene, subject)
+	if err != nil {
+		return err
+	}
+	if err := ob.checkLocked(ctx, key); err != nil {
+		return err
+	}
+	saved, err := ob.repo.GetCode(ctx, key)
+	if err != nil {
+		return otpStoreError(err)
+	}
+	if saved == "" {
+		return ErrOtpExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(saved), []byte(strings.TrimSpace(code))) != 1 {
+		n, err := ob.repo.Fail(ctx, key, sc.MaxAttempts, sc.Lockout)
+		if err != nil {
+			return otpStoreError(err)
+		}
+		if n >= sc.MaxAttempts {
+			ob.log.WithContext(ctx).Warnf("verify code locked, scene: %s, subject: %s", scene, subject)
+			return ErrOtpLocked.WithMetadata(map[string]string{"retry_after": strconv.FormatInt(int64(sc.Lockout.Seconds()), 10)})
+		}
+		return ErrOtpMismatch.WithMetadata(map[string]string{"remaining_attempts": strconv.FormatInt(sc.MaxAttempts-n, 10)})
+	}
+	// 比较和删除之间，验证码可能已被并发的请求使用
+	ok, err := ob.repo.ConsumeCode(ctx, key)
+	if err != nil {
+		return otpStoreError(err)
+	}
+	if !ok {
+		return ErrOtpExpired
+	}
+	if err := ob.repo.ResetAttempts(ctx, key); err != nil {
+		ob.log.WithContext(ctx).Warnf("reset verify code attempts: %v", err)
+	}
+	return nil
+}
+
+// 场景的配置和存储的 key
+func (ob *OtpBiz) scene(scene, subject string) (*OtpScene, string, error) {
+	sc, ok := ob.scenes[scene]
+	if !ok {
+		return nil, "", ErrOtpScene
+	}
+	if !otpSubject(subject) {
+		return nil, "", ErrOtpSubject
+	}
+	return sc, scene + ":" + subject, nil
+}
+
+// 接收方不能为空，不能包含空白字符
+func otpSubject(subject string) bool {
+	return subject != "" && len(subject) <= OtpSubjectMax && strings.IndexFunc(subject, unicode.IsSpace) < 0
+}
+
+func (ob *OtpBiz) checkLocked(ctx context.Context, key string) error {
+	ttl, err := ob.repo.Locked(ctx, key)
+	if err != nil {
+		return otpStoreError(err)
+	}
+	if ttl > 0 {
+		return ErrOtpLocked.WithMetadata(map[string]string{"retry_after": strconv.FormatInt(int64(ttl.Seconds()), 10)})
+	}
+	return nil
+}
+
+func otpStoreError(err error) error {
+	return errors.InternalServer("VERIFY_CODE_ERROR", "verify code store error").WithCause(err)
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"verifyCode/internal/conf"
+)
+
+// 内存中的 OtpRepo，与 Redis 的实现语义一致：失败次数达到上限时删除验证码并锁定
+type fakeOtpRepo struct {
+	codes    map[string]string
+	attempts map[string]int64
+	locks    map[string]time.Duration
+}
+
+func newFakeOtpRepo() *fakeOtpRepo {
+	return &fakeOtpRepo{codes: map[string]string{}, attempts: map[string]int64{}, locks: map[string]time.Duration{}}
+}
+
+func (f *fakeOtpRepo) Locked(ctx context.Context, key string) (time.Duration, error) {
+	return f.locks[key], nil
+}
+
+func (f *fakeOtpRepo) SaveCode(ctx context.Context, key, code string, ttl time.Duration) error {
+	f.codes[key] = code
+	return nil
+}
+
+func (f *fakeOtpRepo) GetCode(ctx context.Context, key string) (string, error) {
+	return f.codes[key], nil
+}
+
+func (f *fakeOtpRepo) ConsumeCode(ctx context.Context, key string) (bool, error) {
+	_, ok := f.codes[key]
+	delete(f.codes, key)
+	return ok, nil
+}
+
+func (f *fakeOtpRepo) Fail(ctx context.Context, key string, max int64, lockout time.Duration) (int64, error) {
+	f.attempts[key]++
+	n := f.attempts[key]
+	if n >= max {
+		delete(f.codes, key)
+		f.locks[key] = lockout
+	}
+	return n, nil
+}
+
+func (f *fakeOtpRepo) ResetAttempts(ctx context.Context, key string) error {
+	delete(f.attempts, key)
+	return nil
+}
+
+func newTestOtpBiz(repo OtpRepo) *OtpBiz {
+	return NewOtpBiz(repo, &conf.Otp{Scenes: map[string]*conf.Otp_Scene{
+		"customer_login": {MaxAttempts: 3, Lockout: durationpb.New(10 * time.Minute)},
+	}}, log.DefaultLogger)
+}
+
+func TestOtpVerify(t *testing.T) {
+	const scene, subject = "customer_login", "13800000000"
+	tests := []struct {
+		name string
+		// 依次校验的验证码，"ok" 表示正确的验证码
+		codes   []string
+		wantErr []error
+	}{
+		{"一次成功", []string{"ok"}, []error{nil}},
+		{"成功后失效", []string{"ok", "ok"}, []error{nil, ErrOtpExpired}},
+		{"失败后成功", []string{"000000", "ok"}, []error{ErrOtpMismatch, nil}},
+		{"成功后清除失败次数", []string{"000000", "000000", "ok"}, []error{ErrOtpMismatch, ErrOtpMismatch, nil}},
+		{"达到上限时锁定", []string{"000000", "000000", "000000"}, []error{ErrOtpMismatch, ErrOtpMismatch, ErrOtpLocked}},
+		{"锁定后正确的验证码也被拒绝", []string{"000000", "000000", "000000", "ok"}, []error{ErrOtpMismatch, ErrOtpMismatch, ErrOtpLocked, ErrOtpLocked}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ob := newTestOtpBiz(newFakeOtpRepo())
+			ctx := context.Background()
+			code, ttl, err := ob.Issue(ctx, scene, subject)
+			if err != nil || len(code) != VerifyCodeLengthDefault || ttl != OtpTTLDefault {
+				t.Fatalf("Issue = %q, %s, %v", code, ttl, err)
+			}
+			for i, c := range tt.codes {
+				if c == "ok" {
+					c = " " + code + " "
+				} else if c == code {
+					c = "999999"
+				}
+				err := ob.Verify(ctx, scene, subject, c)
+				if tt.wantErr[i] == nil {
+					if err != nil {
+						t.Fatalf("verify %d: %v", i, err)
+					}
+					continue
+				}
+				if !errors.Is(err, tt.wantErr[i]) {
+					t.Fatalf("verify %d: error = %v, want %v", i, err, tt.wantErr[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOtpMismatchMetadata(t *testing.T) {
+	ob := newTestOtpBiz(newFakeOtpRepo())
+	ctx := context.Background()
+	code, _, err := ob.Issue(ctx, "customer_login", "13800000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	err = ob.Verify(ctx, "customer_login", "13800000000", wrong)
+	if e := errors.FromError(err); e.Metadata["remaining_attempts"] != "2" {
+		t.Fatalf("metadata %v, want remaining_attempts 2", e.Metadata)
+	}
+	ob.Verify(ctx, "customer_login", "13800000000", wrong)
+	err = ob.Verify(ctx, "customer_login", "13800000000", wrong)
+	if e := errors.FromError(err); !errors.Is(err, ErrOtpLocked) || e.Metadata["retry_after"] != "600" {
+		t.Fatalf("error %v, metadata %v, want locked with retry_after 600", err, e.Metadata)
+	}
+}
+
+func TestOtpLockedIssue(t *testing.T) {
+	repo := newFakeOtpRepo()
+	ob := newTestOtpBiz(repo)
+	ctx := context.Background()
+	repo.locks["customer_login:13800000000"] = 5 * time.Minute
+	if _, _, err := ob.Issue(ctx, "customer_login", "13800000000"); !errors.Is(err, ErrOtpLocked) {
+		t.Fatalf("Issue while locked: %v, want ErrOtpLocked", err)
+	}
+	// 锁定只影响同一场景、同一接收方
+	if _, _, err := ob.Issue(ctx, "customer_login", "13900000000"); err != nil {
+		t.Fatalf("Issue for another subject: %v", err)
+	}
+}
+
+func TestOtpParams(t *testing.T) {
+	ob := newTestOtpBiz(newFakeOtpRepo())
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		scene   string
+		subject string
+		wantErr error
+	}{
+		{"未知场景", "driver_login", "13800000000", ErrOtpScene},
+		{"接收方为空", "customer_login", "", ErrOtpSubject},
+		{"接收方包含空白", "customer_login", "138 0000 0000", ErrOtpSubject},
+		{"接收方太长", "customer_login", string(make([]byte, OtpSubjectMax+1)), ErrOtpSubject},
+	}
+	for _, tt := range tests {
+		if _, _, err := ob.Issue(ctx, tt.scene, tt.subject); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Issue error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if err := ob.Verify(ctx, tt.scene, tt.subject, "123456"); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Verify error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"verifyCode/internal/biz"
+	"verifyCode/internal/conf"
+)
+
+type LimitData struct {
+	data *Data
+}
+
+func NewLimitRepo(data *Data) biz.LimitRepo {
+	return &LimitData{data: data}
+}
+
+// 频率限制的 key
+func limitKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	return "OTPR:" + key
+}
+
+// KEYS：熔断、冷却、号码、IP、设备、全局窗口，为空的 key 不检查
+// ARGV：冷却时长、号码/IP/设备每天的次数、当天剩余时长、全局次数、全局窗口、熔断时长（时长单位 ms）
+// 返回被拒绝的原因（与 biz.LimitPassed 等常量一致）和需要等待的时长
+var limitAllowScript = redis.NewScript(`
+if KEYS[1] ~= '' then
+	local t = redis.call('PTTL', KEYS[1])
+	if t > 0 then
+		return {1, t}
+	end
+end
+if KEYS[2] ~= '' then
+	local t = redis.call('PTTL', KEYS[2])
+	if t > 0 then
+		return {2, t}
+	end
+end
+for i = 3, 5 do
+	if KEYS[i] ~= '' and tonumber(redis.call('GET', KEYS[i]) or '0') >= tonumber(ARGV[i - 1]) then
+		return {i, redis.call('PTTL', KEYS[i])}
+	end
+end
+if KEYS[6] ~= '' then
+	local g = redis.call('INCR', KEYS[6])
+	if g == 1 then
+		redis.call('PEXPIRE', KEYS[6], ARGV[7])
+	end
+	if g > tonumber(ARGV[6]) then
+		redis.call('SET', KEYS[1], 1, 'PX', ARGV[8])
+		return {1, tonumber(ARGV[8])}
+	end
+end
+if KEYS[2] ~= '' then
+	redis.call('SET', KEYS[2], 1, 'PX', ARGV[1])
+end
+for i = 3, 5 do
+	if KEYS[i] ~= '' and redis.call('INCR', KEYS[i]) == 1 then
+		redis.call('PEXPIRE', KEYS[i], ARGV[5])
+	end
+end
+return {0, 0}
+`)
+
+func (ld *LimitData) Allow(ctx context.Context, keys *biz.LimitKeys, limit *conf.Otp_Limit, dayLeft time.Duration) (int, time.Duration, error) {
+	ks := []string{
+		limitKey(keys.Breaker),
+		limitKey(keys.Cooldown),
+		limitKey(keys.Subject),
+		limitKey(keys.IP),
+		limitKey(keys.Device),
+		limitKey(keys.Global),
+	}
+	args := []interface{}{
+		limit.GetCooldown().AsDuration().Milliseconds(),
+		limit.GetSubjectDaily(),
+		limit.GetIpDaily(),
+		limit.GetDeviceDaily(),
+		dayLeft.Milliseconds(),
+		limit.GetGlobalMax(),
+		limit.GetGlobalWindow().AsDuration().Milliseconds(),
+		limit.GetBreaker().AsDuration().Milliseconds(),
+	}
+	result, err := limitAllowScript.Run(ctx, ld.data.Rdb, ks, args...).Int64Slice()
+	if err != nil {
+		return biz.LimitPassed, 0, err
+	}
+	return int(result[0]), time.Duration(result[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"verifyCode/internal/biz"
+)
+
+type OtpData struct {
+	data *Data
+}
+
+func NewOtpRepo(data *Data) biz.OtpRepo {
+	return &OtpData{data: data}
+}
+
+// 验证码、失败次数、锁定标记，key 使用 hash tag，集群模式下位于同一个 slot，可以在脚本中一起操作
+func otpCodeKey(key string) string {
+	return "OTP:{" + key + "}"
+}
+
+func otpAttemptsKey(key string) string {
+	return "OTPA:{" + key + "}"
+}
+
+func otpLockKey(key string) string {
+	return "OTPL:{" + key + "}"
+}
+
+// 失败次数加一，第一次失败时开始统计窗口；达到上限时删除验证码和失败次数，并锁定
+var otpFailScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[2])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[2], ARGV[2])
+end
+if n >= tonumber(ARGV[1]) then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	redis.call('SET', KEYS[3], 1, 'PX', ARGV[2])
+end
+return n
+`)
+
+func (od *OtpData) Locked(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := od.data.Rdb.PTTL(ctx, otpLockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	// key 不存在时为负数
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (od *OtpData) SaveCode(ctx context.Context, key, code string, ttl time.Duration) error {
+	return od.data.Rdb.Set(ctx, otpCodeKey(key), code, ttl).Err()
+}
+
+func (od *OtpData) GetCode(ctx context.Context, key string) (string, error) {
+	code, err := od.data.Rdb.Get(ctx, otpCodeKey(key)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return code, err
+}
+
+func (od *OtpData) ConsumeCode(ctx context.Context, key string) (bool, error) {
+	n, err := od.data.Rdb.Del(ctx, otpCodeKey(key)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (od *OtpData) Fail(ctx context.Context, key string, max int64, lockout time.Duration) (int64, error) {
+	keys := []string{otpCodeKey(key), otpAttemptsKey(key), otpLockKey(key)}
+	return otpFailScript.Run(ctx, od.data.Rdb, keys, max, lockout.Milliseconds()).Int64()
+}
+
+func (od *OtpData) ResetAttempts(ctx context.Context, key string) error {
+	return od.data.Rdb.Del(ctx, otpAttemptsKey(key)).Err()
+}